@@ -0,0 +1,118 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+// ErrInvalidAPIKey is returned when an API key or prefix is malformed or its checksum does not match.
+var ErrInvalidAPIKey = errors.New("random: invalid API key")
+
+const (
+	// apiKeySecretLength is the number of base62 characters of the secret (about 178 bits).
+	apiKeySecretLength = 30
+	// apiKeyChecksumLength fits any CRC32 value, since 62^6 > 2^32.
+	apiKeyChecksumLength = 6
+)
+
+// APIKey generates a cryptographically secure API key of the form
+// prefix + "_" + 30 random base62 characters + 6-character base62 CRC32 checksum,
+// similar to GitHub's token format. The prefix lets secret scanners spot leaked keys and
+// the checksum lets clients detect typos offline with VerifyAPIKey.
+// The prefix may contain letters, digits and underscores, and must not start or end with
+// an underscore. Returns ErrInvalidAPIKey for invalid prefixes.
+//
+// Example:
+//
+//	key, err := random.APIKey("sk_live") // "sk_live_3xK9...q1Z0aB"
+//	if err != nil {
+//	    return err
+//	}
+func APIKey(prefix string) (string, error) {
+	return secureGenerator.APIKey(prefix)
+}
+
+// APIKey generates an API key with the given prefix, drawing randomness from the
+// Generator's Source. See the package-level APIKey for details.
+func (g *Generator) APIKey(prefix string) (string, error) {
+	if err := validateAPIKeyPrefix(prefix); err != nil {
+		return "", err
+	}
+	key := prefix + "_" + g.StringN(apiKeySecretLength, base62Alphabet)
+	return key + apiKeyChecksum(key), nil
+}
+
+// ParseAPIKey splits an API key into its prefix and secret after checking its format
+// and checksum. The secret excludes the checksum.
+// Returns ErrInvalidAPIKey if the key is malformed or the checksum does not match.
+//
+// Example:
+//
+//	prefix, secret, err := random.ParseAPIKey(key)
+//	if err != nil {
+//	    return err // typo or not one of our keys
+//	}
+func ParseAPIKey(key string) (prefix, secret string, err error) {
+	sep := strings.LastIndexByte(key, '_')
+	if sep < 0 {
+		return "", "", fmt.Errorf("%w: missing prefix", ErrInvalidAPIKey)
+	}
+	prefix, payload := key[:sep], key[sep+1:]
+	if err := validateAPIKeyPrefix(prefix); err != nil {
+		return "", "", err
+	}
+	if len(payload) != apiKeySecretLength+apiKeyChecksumLength {
+		return "", "", fmt.Errorf("%w: unexpected length", ErrInvalidAPIKey)
+	}
+	for i := 0; i < len(payload); i++ {
+		if strings.IndexByte(base62Alphabet, payload[i]) < 0 {
+			return "", "", fmt.Errorf("%w: illegal character %q", ErrInvalidAPIKey, payload[i])
+		}
+	}
+
+	secret, checksum := payload[:apiKeySecretLength], payload[apiKeySecretLength:]
+	if apiKeyChecksum(prefix+"_"+secret) != checksum {
+		return "", "", fmt.Errorf("%w: checksum mismatch", ErrInvalidAPIKey)
+	}
+	return prefix, secret, nil
+}
+
+// VerifyAPIKey reports whether key is well-formed, has the expected prefix and a valid
+// checksum. It does not tell whether the key was ever issued; that still requires a lookup.
+//
+// Example:
+//
+//	if !random.VerifyAPIKey(key, "sk_live") {
+//	    return errors.New("malformed API key")
+//	}
+func VerifyAPIKey(key, prefix string) bool {
+	got, _, err := ParseAPIKey(key)
+	return err == nil && got == prefix
+}
+
+// apiKeyChecksum returns the CRC32 (IEEE) checksum of s as fixed-width base62.
+func apiKeyChecksum(s string) string {
+	sum := uint64(crc32.ChecksumIEEE([]byte(s)))
+	b := make([]byte, apiKeyChecksumLength)
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = base62Alphabet[sum%62]
+		sum /= 62
+	}
+	return string(b)
+}
+
+// validateAPIKeyPrefix checks that prefix is non-empty, consists of letters, digits and
+// underscores, and neither starts nor ends with an underscore.
+func validateAPIKeyPrefix(prefix string) error {
+	if prefix == "" || prefix[0] == '_' || prefix[len(prefix)-1] == '_' {
+		return fmt.Errorf("%w: invalid prefix %q", ErrInvalidAPIKey, prefix)
+	}
+	for i := 0; i < len(prefix); i++ {
+		if c := prefix[i]; c != '_' && strings.IndexByte(Alphanumeric, c) < 0 {
+			return fmt.Errorf("%w: invalid prefix %q", ErrInvalidAPIKey, prefix)
+		}
+	}
+	return nil
+}
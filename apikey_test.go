@@ -0,0 +1,98 @@
+package random_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestAPIKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("format", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := random.APIKey("sk_live")
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^sk_live_[0-9A-Za-z]{36}$`), key)
+	})
+
+	t.Run("parse and verify", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := random.APIKey("ghp")
+		require.NoError(t, err)
+
+		prefix, secret, err := random.ParseAPIKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, "ghp", prefix)
+		assert.Len(t, secret, 30)
+		assert.True(t, strings.HasPrefix(key, "ghp_"+secret))
+
+		assert.True(t, random.VerifyAPIKey(key, "ghp"))
+		assert.False(t, random.VerifyAPIKey(key, "sk_live"))
+	})
+
+	t.Run("detects typos", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := random.APIKey("sk_test")
+		require.NoError(t, err)
+
+		for i := len("sk_test_"); i < len(key); i++ {
+			typo := []byte(key)
+			if typo[i] == 'a' {
+				typo[i] = 'b'
+			} else {
+				typo[i] = 'a'
+			}
+			_, _, err := random.ParseAPIKey(string(typo))
+			require.ErrorIs(t, err, random.ErrInvalidAPIKey, string(typo))
+		}
+
+		// Typos in the prefix are caught too
+		_, _, err = random.ParseAPIKey("sk_tast" + key[len("sk_test"):])
+		require.ErrorIs(t, err, random.ErrInvalidAPIKey)
+	})
+
+	t.Run("invalid prefixes", func(t *testing.T) {
+		t.Parallel()
+
+		for _, prefix := range []string{"", "_sk", "sk_", "sk-live", "ключ"} {
+			_, err := random.APIKey(prefix)
+			require.ErrorIs(t, err, random.ErrInvalidAPIKey, prefix)
+		}
+	})
+
+	t.Run("malformed keys", func(t *testing.T) {
+		t.Parallel()
+
+		for _, key := range []string{
+			"",
+			"nounderscore",
+			"sk_short",
+			"sk_" + strings.Repeat("a", 35) + "-",
+			"_" + strings.Repeat("a", 36),
+		} {
+			_, _, err := random.ParseAPIKey(key)
+			require.ErrorIs(t, err, random.ErrInvalidAPIKey, key)
+			require.False(t, random.VerifyAPIKey(key, "sk"))
+		}
+	})
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := random.NewSeeded(15).APIKey("test")
+		require.NoError(t, err)
+		b, err := random.NewSeeded(15).APIKey("test")
+		require.NoError(t, err)
+		require.Equal(t, a, b)
+		require.True(t, random.VerifyAPIKey(a, "test"))
+	})
+}
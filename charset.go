@@ -0,0 +1,198 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrEmptyCharset is returned when a charset contains no characters.
+	ErrEmptyCharset = errors.New("random: empty charset")
+	// ErrInvalidCharset is returned when a charset is not valid UTF-8 or a range is malformed.
+	ErrInvalidCharset = errors.New("random: invalid charset")
+)
+
+// Charset is an ordered set of characters used to generate random strings.
+// The zero value is an empty charset. Set operations deduplicate their results and
+// preserve the order in which characters first appear, so a Charset built with them
+// yields an exactly uniform distribution over its distinct characters.
+//
+// The charset constants (Uppercase, Numeric, ...) are untyped, so they can be used as
+// Charset values directly. Only the generic ...Of functions accept a Charset as is:
+// StringOf, SecureStringOf, StringOfFrom, EntropyOf, LengthForOf, SecureStringForEntropyOf
+// and UniqueStringsOf. Like RuneString, they pick grapheme clusters, so a non-ASCII Charset
+// works with them. All other functions, such as String, NanoID or WriteString, take plain
+// strings that must be ASCII; pass them cs.String():
+//
+//	cs := random.Charset(random.Alphanumeric).Minus(random.Charset("0O1lI"))
+//	code := random.StringOf(8, cs)
+//	code := random.String(8, cs.String())
+type Charset string
+
+// NewCharset returns the deduplicated union of the given character sets.
+//
+// Example:
+//
+//	cs := random.NewCharset(random.Alphanumeric, random.Numeric) // digits are not double-weighted
+func NewCharset(charsets ...string) Charset {
+	var sb strings.Builder
+	seen := make(map[rune]bool)
+	for _, charset := range charsets {
+		for _, r := range charset {
+			if !seen[r] {
+				seen[r] = true
+				sb.WriteRune(r)
+			}
+		}
+	}
+	return Charset(sb.String())
+}
+
+// ParseCharset parses a charset specification with ranges, such as "a-zA-Z0-9".
+// A backslash escapes the following character, so `\-` and `\\` denote a literal dash
+// and backslash. A dash at the start or end of the spec is literal.
+// The result is deduplicated. Returns ErrInvalidCharset for malformed specs and
+// ErrEmptyCharset if the spec is empty.
+//
+// Example:
+//
+//	cs, err := random.ParseCharset("a-f0-9")  // same characters as random.Hex
+func ParseCharset(spec string) (Charset, error) {
+	if !utf8.ValidString(spec) {
+		return "", fmt.Errorf("%w: not valid UTF-8", ErrInvalidCharset)
+	}
+
+	var runes []rune
+	src := []rune(spec)
+	for i := 0; i < len(src); i++ {
+		r := src[i]
+		if r == '\\' {
+			if i+1 == len(src) {
+				return "", fmt.Errorf("%w: trailing backslash", ErrInvalidCharset)
+			}
+			i++
+			r = src[i]
+		}
+
+		// A dash between two characters denotes a range
+		if i+2 < len(src) && src[i+1] == '-' {
+			hi := src[i+2]
+			i += 2
+			if hi == '\\' {
+				if i+1 == len(src) {
+					return "", fmt.Errorf("%w: trailing backslash", ErrInvalidCharset)
+				}
+				i++
+				hi = src[i]
+			}
+			if hi < r {
+				return "", fmt.Errorf("%w: range %q-%q is out of order", ErrInvalidCharset, r, hi)
+			}
+			for c := r; c <= hi; c++ {
+				runes = append(runes, c)
+			}
+			continue
+		}
+
+		runes = append(runes, r)
+	}
+
+	if len(runes) == 0 {
+		return "", ErrEmptyCharset
+	}
+	return NewCharset(string(runes)), nil
+}
+
+// MustParseCharset is like ParseCharset but panics if the spec cannot be parsed.
+// It simplifies safe initialization of package-level variables.
+func MustParseCharset(spec string) Charset {
+	cs, err := ParseCharset(spec)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+// String returns the characters of the charset as a string.
+func (c Charset) String() string {
+	return string(c)
+}
+
+// Len returns the number of distinct characters in the charset.
+func (c Charset) Len() int {
+	return utf8.RuneCountInString(string(c.Dedup()))
+}
+
+// Contains reports whether the charset contains r.
+func (c Charset) Contains(r rune) bool {
+	return strings.ContainsRune(string(c), r)
+}
+
+// Dedup returns the charset with duplicate characters removed, keeping first occurrences.
+func (c Charset) Dedup() Charset {
+	return NewCharset(string(c))
+}
+
+// Union returns the characters that are in c or in any of the others.
+func (c Charset) Union(others ...Charset) Charset {
+	charsets := make([]string, 0, len(others)+1)
+	charsets = append(charsets, string(c))
+	for _, other := range others {
+		charsets = append(charsets, string(other))
+	}
+	return NewCharset(charsets...)
+}
+
+// Minus returns the characters of c that are in none of the others.
+func (c Charset) Minus(others ...Charset) Charset {
+	return c.filter(func(r rune) bool {
+		for _, other := range others {
+			if other.Contains(r) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Intersect returns the characters of c that are in all of the others.
+func (c Charset) Intersect(others ...Charset) Charset {
+	return c.filter(func(r rune) bool {
+		for _, other := range others {
+			if !other.Contains(r) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WithoutAmbiguous returns the charset with the Ambiguous look-alike characters removed.
+func (c Charset) WithoutAmbiguous() Charset {
+	return c.Minus(Ambiguous)
+}
+
+// Validate reports whether the charset can be used for generation.
+// Returns ErrInvalidCharset if it is not valid UTF-8 and ErrEmptyCharset if it is empty.
+func (c Charset) Validate() error {
+	if !utf8.ValidString(string(c)) {
+		return fmt.Errorf("%w: not valid UTF-8", ErrInvalidCharset)
+	}
+	if c == "" {
+		return ErrEmptyCharset
+	}
+	return nil
+}
+
+// filter returns the deduplicated characters of c for which keep returns true.
+func (c Charset) filter(keep func(r rune) bool) Charset {
+	var sb strings.Builder
+	for _, r := range c.Dedup() {
+		if keep(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return Charset(sb.String())
+}
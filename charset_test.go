@@ -0,0 +1,251 @@
+package random_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestNewCharset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("deduplicates and keeps order", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.NewCharset("abca", "cde")
+		assert.Equal(t, random.Charset("abcde"), cs)
+	})
+
+	t.Run("overlapping constants", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.NewCharset(random.Alphanumeric, random.Numeric)
+		assert.Equal(t, random.Charset(random.Alphanumeric), cs)
+		assert.Equal(t, 62, cs.Len())
+	})
+
+	t.Run("multibyte runes", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.NewCharset("αβ", "βγ")
+		assert.Equal(t, random.Charset("αβγ"), cs)
+		assert.Equal(t, 3, cs.Len())
+	})
+}
+
+func TestParseCharset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec string
+		want random.Charset
+	}{
+		{"alphanumeric ranges", "a-zA-Z0-9", random.Lowercase + random.Uppercase + random.Numeric},
+		{"hex", "0-9a-f", random.Hex},
+		{"literal characters", "xyz", "xyz"},
+		{"leading dash", "-a-c", "-abc"},
+		{"trailing dash", "a-c-", "abc-"},
+		{"escaped dash", `a\-c`, "a-c"},
+		{"escaped backslash", `\\a`, `\a`},
+		{"escaped range bounds", `\--/`, "-./"},
+		{"deduplicated", "a-cb-d", "abcd"},
+		{"single character range", "a-a", "a"},
+		{"unicode range", "α-γ", "αβγ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := random.ParseCharset(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.ParseCharset("")
+		require.ErrorIs(t, err, random.ErrEmptyCharset)
+
+		_, err = random.ParseCharset("z-a")
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+		_, err = random.ParseCharset(`abc\`)
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+		_, err = random.ParseCharset("a\xffb")
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+	})
+
+	t.Run("must parse panics on error", func(t *testing.T) {
+		t.Parallel()
+
+		require.Panics(t, func() { random.MustParseCharset("z-a") })
+		require.Equal(t, random.Charset(random.Numeric), random.MustParseCharset("0-9"))
+	})
+}
+
+func TestCharset_SetOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("union", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.Charset(random.Uppercase).Union(random.Numeric, "XYZ!")
+		assert.Equal(t, random.Charset(random.Uppercase+random.Numeric+"!"), cs)
+	})
+
+	t.Run("minus", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.Charset(random.Hex).Minus(random.Numeric)
+		assert.Equal(t, random.Charset("abcdef"), cs)
+
+		cs = random.Charset(random.Numeric).Minus("01", "89")
+		assert.Equal(t, random.Charset("234567"), cs)
+	})
+
+	t.Run("intersect", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.Charset(random.Alphanumeric).Intersect(random.Hex)
+		assert.Equal(t, random.Charset("abcdef0123456789"), cs)
+
+		cs = random.Charset(random.Alphanumeric).Intersect(random.Hex, random.Lowercase)
+		assert.Equal(t, random.Charset("abcdef"), cs)
+	})
+
+	t.Run("results are deduplicated", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, random.Charset("ab"), random.Charset("aabb").Minus("c"))
+		assert.Equal(t, random.Charset("ab"), random.Charset("aabb").Intersect("ab"))
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		t.Parallel()
+
+		cs := random.Charset(random.Hex)
+		assert.True(t, cs.Contains('f'))
+		assert.False(t, cs.Contains('g'))
+	})
+}
+
+func TestCharset_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, random.Charset(random.Alphanumeric).Validate())
+	assert.ErrorIs(t, random.Charset("").Validate(), random.ErrEmptyCharset)
+	assert.ErrorIs(t, random.Charset("a\xff").Validate(), random.ErrInvalidCharset)
+}
+
+func TestString_DeduplicatedCharsets(t *testing.T) {
+	t.Parallel()
+
+	// Without deduplication digits would be picked twice as often as letters
+	counts := make(map[bool]int)
+	for i := 0; i < 40; i++ {
+		for _, char := range random.String(250, "abcde", "01234", "01234") {
+			counts[char >= '0' && char <= '9']++
+		}
+	}
+
+	require.InDelta(t, 5000, counts[true], 400)
+	require.InDelta(t, 5000, counts[false], 400)
+}
+
+func TestStringOf(t *testing.T) {
+	t.Parallel()
+
+	cs := random.MustParseCharset("a-f").Minus("e")
+
+	t.Run("charset values", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Regexp(t, `^[abcdf]{50}$`, random.StringOf(50, cs))
+		assert.Regexp(t, `^[abcdf0-9]{50}$`, random.StringOf(50, cs, random.Numeric))
+		assert.Regexp(t, `^[A-Za-z0-9]{10}$`, random.StringOf[random.Charset](10))
+
+		s, err := random.SecureStringOf(32, cs)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[abcdf]{32}$`, s)
+	})
+
+	t.Run("non-ASCII charset", func(t *testing.T) {
+		t.Parallel()
+
+		s := random.StringOf(8, random.Charset("αβγ").Union("🍎"))
+		assert.True(t, utf8.ValidString(s))
+		assert.Equal(t, 8, utf8.RuneCountInString(s))
+	})
+
+	t.Run("matches StringN for ASCII", func(t *testing.T) {
+		t.Parallel()
+
+		want := random.NewSeeded(3).StringN(20, cs.String())
+		assert.Equal(t, want, random.StringOfFrom(random.NewSeeded(3), 20, cs))
+	})
+}
+
+func TestCharsetHelpers(t *testing.T) {
+	t.Parallel()
+
+	cs := random.MustParseCharset("a-f").Minus("e")
+
+	t.Run("entropy", func(t *testing.T) {
+		t.Parallel()
+
+		assert.InDelta(t, random.Entropy(10, cs.String()), random.EntropyOf(10, cs), 1e-9)
+		assert.Equal(t, random.LengthFor(64, cs.String()), random.LengthForOf(64, cs))
+
+		// Non-ASCII characters and multi-rune emoji count once each, unlike with Entropy
+		assert.InDelta(t, 8.0, random.EntropyOf(4, random.Charset("αβ🇺🇦👍🏽")), 1e-9)
+		assert.Equal(t, 64, random.LengthForOf(128, random.Charset("αβ🇺🇦👍🏽")))
+
+		s, err := random.SecureStringForEntropyOf(20, cs, random.Numeric)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[abcdf0-9]{6}$`, s)
+
+		_, err = random.SecureStringForEntropyOf(128, random.Charset("ж"))
+		require.ErrorIs(t, err, random.ErrEntropyTooHigh)
+	})
+
+	t.Run("unique strings", func(t *testing.T) {
+		t.Parallel()
+
+		codes, stats, err := random.UniqueStringsOf(25, 2, cs)
+		require.NoError(t, err)
+		assert.True(t, stats.Dense)
+		assert.Len(t, uniqueSet(codes), 25)
+
+		codes, _, err = random.UniqueStringsOf(9, 2, random.Charset("жщ🇺🇦"))
+		require.NoError(t, err)
+		assert.Len(t, uniqueSet(codes), 9)
+		for _, code := range codes {
+			assert.True(t, utf8.ValidString(code))
+		}
+
+		_, _, err = random.UniqueStringsOf(10, 2, random.Charset("жщ🇺🇦"))
+		require.ErrorIs(t, err, random.ErrKeyspaceTooSmall)
+	})
+}
+
+// uniqueSet returns the distinct strings of s.
+func uniqueSet(s []string) []string {
+	seen := make(map[string]bool, len(s))
+	var out []string
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,245 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPayload is returned when a payload contains characters that the check digit
+// algorithm does not support.
+var ErrInvalidPayload = errors.New("random: invalid check digit payload")
+
+// CheckDigitAlgorithm selects how check characters are computed.
+type CheckDigitAlgorithm int
+
+const (
+	// CheckLuhn is the Luhn (mod 10) algorithm used by payment cards. It detects all
+	// single-digit errors and most adjacent transpositions. Numeric payloads only.
+	CheckLuhn CheckDigitAlgorithm = iota + 1
+	// CheckDamm is the Damm algorithm. It detects all single-digit errors and all adjacent
+	// transpositions. Numeric payloads only.
+	CheckDamm
+	// CheckVerhoeff is the Verhoeff algorithm. It detects all single-digit errors and all
+	// adjacent transpositions. Numeric payloads only.
+	CheckVerhoeff
+	// CheckISO7064 is the ISO/IEC 7064 MOD 37,36 hybrid system for alphanumeric payloads
+	// (0-9, A-Z, case-insensitive). It detects all single-character errors and most
+	// adjacent transpositions. The check character is also from 0-9A-Z.
+	CheckISO7064
+)
+
+// iso7064Alphabet is the character set of ISO/IEC 7064 MOD 37,36.
+const iso7064Alphabet = Numeric + Uppercase
+
+var (
+	dammTable = [10][10]byte{
+		{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+		{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+		{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+		{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+		{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+		{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+		{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+		{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+		{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+		{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+	}
+
+	verhoeffMultiplication = [10][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffPermutation = [8][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+	verhoeffInverse = [10]byte{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+)
+
+// String returns the name of the algorithm.
+func (a CheckDigitAlgorithm) String() string {
+	switch a {
+	case CheckLuhn:
+		return "luhn"
+	case CheckDamm:
+		return "damm"
+	case CheckVerhoeff:
+		return "verhoeff"
+	case CheckISO7064:
+		return "iso7064-mod37-36"
+	}
+	return fmt.Sprintf("CheckDigitAlgorithm(%d)", int(a))
+}
+
+// CheckDigitCode generates a random code of the given total length whose last character
+// is a check character computed with alg. The payload is numeric for Luhn, Damm and
+// Verhoeff, and uppercase alphanumeric for ISO 7064. Returns an empty string if
+// length < 2 or alg is unknown.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	voucher := random.CheckDigitCode(12, random.CheckDamm)   // "48213907552" + check digit
+//	account := random.CheckDigitCode(10, random.CheckISO7064) // "K7Q2M9XA4" + check character
+func CheckDigitCode(length int, alg CheckDigitAlgorithm) string {
+	return defaultGenerator.CheckDigitCode(length, alg)
+}
+
+// CheckDigitCode generates a random code ending in a check character, drawing randomness
+// from the Generator's Source. See the package-level CheckDigitCode for details.
+func (g *Generator) CheckDigitCode(length int, alg CheckDigitAlgorithm) string {
+	if length < 2 {
+		return ""
+	}
+	charset := Numeric
+	if alg == CheckISO7064 {
+		charset = iso7064Alphabet
+	}
+	code, err := AppendCheckDigit(g.StringN(length-1, charset), alg)
+	if err != nil {
+		return ""
+	}
+	return code
+}
+
+// AppendCheckDigit returns payload followed by its check character.
+// Returns ErrInvalidPayload if the payload is empty or has characters the algorithm
+// does not support.
+//
+// Example:
+//
+//	code, err := random.AppendCheckDigit("7992739871", random.CheckLuhn) // "79927398713"
+func AppendCheckDigit(payload string, alg CheckDigitAlgorithm) (string, error) {
+	check, err := ComputeCheckDigit(payload, alg)
+	if err != nil {
+		return "", err
+	}
+	return payload + string(check), nil
+}
+
+// ComputeCheckDigit returns the check character for payload.
+// Returns ErrInvalidPayload if the payload is empty or has characters the algorithm
+// does not support.
+func ComputeCheckDigit(payload string, alg CheckDigitAlgorithm) (byte, error) {
+	if payload == "" {
+		return 0, fmt.Errorf("%w: empty payload", ErrInvalidPayload)
+	}
+
+	if alg == CheckISO7064 {
+		payload = strings.ToUpper(payload)
+		for i := 0; i < len(payload); i++ {
+			if strings.IndexByte(iso7064Alphabet, payload[i]) < 0 {
+				return 0, fmt.Errorf("%w: illegal character %q for %v", ErrInvalidPayload, payload[i], alg)
+			}
+		}
+		return iso7064Check(payload), nil
+	}
+
+	digits := make([]byte, len(payload))
+	for i := 0; i < len(payload); i++ {
+		if payload[i] < '0' || payload[i] > '9' {
+			return 0, fmt.Errorf("%w: illegal character %q for %v", ErrInvalidPayload, payload[i], alg)
+		}
+		digits[i] = payload[i] - '0'
+	}
+
+	switch alg {
+	case CheckLuhn:
+		return '0' + luhnCheck(digits), nil
+	case CheckDamm:
+		return '0' + dammCheck(digits), nil
+	case CheckVerhoeff:
+		return '0' + verhoeffCheck(digits), nil
+	}
+	return 0, fmt.Errorf("%w: unknown algorithm %v", ErrInvalidPayload, alg)
+}
+
+// ValidateCheckDigit reports whether the last character of code is the correct check
+// character for the rest of it.
+//
+// Example:
+//
+//	if !random.ValidateCheckDigit(input, random.CheckDamm) {
+//	    return errors.New("mistyped voucher code")
+//	}
+func ValidateCheckDigit(code string, alg CheckDigitAlgorithm) bool {
+	if len(code) < 2 {
+		return false
+	}
+	payload, check := code[:len(code)-1], code[len(code)-1]
+	want, err := ComputeCheckDigit(payload, alg)
+	if err != nil {
+		return false
+	}
+	if alg == CheckISO7064 && check >= 'a' && check <= 'z' {
+		check -= 'a' - 'A'
+	}
+	return check == want
+}
+
+// luhnCheck returns the Luhn check digit for digits.
+func luhnCheck(digits []byte) byte {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i])
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte((10 - sum%10) % 10)
+}
+
+// dammCheck returns the Damm check digit for digits.
+func dammCheck(digits []byte) byte {
+	var interim byte
+	for _, d := range digits {
+		interim = dammTable[interim][d]
+	}
+	return interim
+}
+
+// verhoeffCheck returns the Verhoeff check digit for digits.
+func verhoeffCheck(digits []byte) byte {
+	var c byte
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		c = verhoeffMultiplication[c][verhoeffPermutation[(i+1)%8][d]]
+	}
+	return verhoeffInverse[c]
+}
+
+// iso7064Check returns the ISO/IEC 7064 MOD 37,36 check character for an uppercase
+// alphanumeric payload.
+func iso7064Check(payload string) byte {
+	const m = 36
+	p := m
+	for i := 0; i < len(payload); i++ {
+		s := (p + strings.IndexByte(iso7064Alphabet, payload[i])) % m
+		if s == 0 {
+			s = m
+		}
+		p = (s * 2) % (m + 1)
+	}
+	return iso7064Alphabet[(m+1-p)%m]
+}
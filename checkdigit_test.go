@@ -0,0 +1,148 @@
+package random_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+var allCheckDigitAlgorithms = []random.CheckDigitAlgorithm{
+	random.CheckLuhn,
+	random.CheckDamm,
+	random.CheckVerhoeff,
+	random.CheckISO7064,
+}
+
+func TestComputeCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		alg     random.CheckDigitAlgorithm
+		payload string
+		want    byte
+	}{
+		{random.CheckLuhn, "7992739871", '3'},
+		{random.CheckLuhn, "4111111111111111"[:15], '1'},
+		{random.CheckDamm, "572", '4'},
+		{random.CheckVerhoeff, "236", '3'},
+		{random.CheckVerhoeff, "12345", '1'},
+		{random.CheckISO7064, "A12425GABC1234002", 'M'},
+		{random.CheckISO7064, "a12425gabc1234002", 'M'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alg.String()+"/"+tt.payload, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := random.ComputeCheckDigit(tt.payload, tt.alg)
+			require.NoError(t, err)
+			assert.Equal(t, string(tt.want), string(got))
+
+			code, err := random.AppendCheckDigit(tt.payload, tt.alg)
+			require.NoError(t, err)
+			assert.True(t, random.ValidateCheckDigit(code, tt.alg))
+		})
+	}
+
+	t.Run("invalid payloads", func(t *testing.T) {
+		t.Parallel()
+
+		for _, alg := range allCheckDigitAlgorithms {
+			_, err := random.ComputeCheckDigit("", alg)
+			require.ErrorIs(t, err, random.ErrInvalidPayload, alg)
+
+			_, err = random.ComputeCheckDigit("12-34", alg)
+			require.ErrorIs(t, err, random.ErrInvalidPayload, alg)
+		}
+
+		_, err := random.ComputeCheckDigit("12AB", random.CheckLuhn)
+		require.ErrorIs(t, err, random.ErrInvalidPayload)
+
+		_, err = random.ComputeCheckDigit("1234", random.CheckDigitAlgorithm(0))
+		require.ErrorIs(t, err, random.ErrInvalidPayload)
+	})
+}
+
+func TestValidateCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("detects single character errors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, alg := range allCheckDigitAlgorithms {
+			code := random.CheckDigitCode(12, alg)
+			require.True(t, random.ValidateCheckDigit(code, alg), code)
+
+			charset := random.Numeric
+			if alg == random.CheckISO7064 {
+				charset = random.Numeric + random.Uppercase
+			}
+			for i := range code {
+				for j := 0; j < len(charset); j++ {
+					if charset[j] == code[i] {
+						continue
+					}
+					typo := []byte(code)
+					typo[i] = charset[j]
+					require.False(t, random.ValidateCheckDigit(string(typo), alg), "%v accepted %s for %s", alg, typo, code)
+				}
+			}
+		}
+	})
+
+	t.Run("detects adjacent transpositions", func(t *testing.T) {
+		t.Parallel()
+
+		for _, alg := range []random.CheckDigitAlgorithm{random.CheckDamm, random.CheckVerhoeff} {
+			for n := 0; n < 20; n++ {
+				code := random.CheckDigitCode(10, alg)
+				for i := 0; i+1 < len(code); i++ {
+					if code[i] == code[i+1] {
+						continue
+					}
+					swapped := []byte(code)
+					swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+					require.False(t, random.ValidateCheckDigit(string(swapped), alg), "%v accepted %s for %s", alg, swapped, code)
+				}
+			}
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, random.ValidateCheckDigit("", random.CheckLuhn))
+		assert.False(t, random.ValidateCheckDigit("5", random.CheckLuhn))
+		assert.False(t, random.ValidateCheckDigit("79927398713", random.CheckDigitAlgorithm(42)))
+		assert.True(t, random.ValidateCheckDigit("a12425gabc1234002m", random.CheckISO7064))
+	})
+}
+
+func TestCheckDigitCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lengths and charsets", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Regexp(t, `^[0-9]{16}$`, random.CheckDigitCode(16, random.CheckLuhn))
+		assert.Regexp(t, `^[0-9]{8}$`, random.CheckDigitCode(8, random.CheckDamm))
+		assert.Regexp(t, `^[0-9]{8}$`, random.CheckDigitCode(8, random.CheckVerhoeff))
+		assert.Regexp(t, `^[0-9A-Z]{10}$`, random.CheckDigitCode(10, random.CheckISO7064))
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "", random.CheckDigitCode(1, random.CheckLuhn))
+		assert.Equal(t, "", random.CheckDigitCode(10, random.CheckDigitAlgorithm(0)))
+	})
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, random.NewSeeded(4).CheckDigitCode(12, random.CheckVerhoeff), random.NewSeeded(4).CheckDigitCode(12, random.CheckVerhoeff))
+	})
+}
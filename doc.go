@@ -9,6 +9,9 @@
 //
 // Predefined character set constants are available for common use cases:
 // Uppercase, Lowercase, Alphabetic, Numeric, Alphanumeric, Symbols, and Hex.
+// For codes that are printed or read aloud, Unambiguous, UnambiguousUpper and CrockfordBase32
+// leave out look-alike characters such as 0/O and 1/l/I, and [WithoutAmbiguous] removes the
+// Ambiguous characters from any charset.
 //
 //	// Generate a random alphanumeric string of length 16
 //	randomID := random.String(16)
@@ -19,6 +22,100 @@
 //	// Combine multiple character sets
 //	randomStr := random.String(20, random.Uppercase, random.Numeric)
 //
+//	// Receipt code without look-alike characters
+//	receiptCode := random.String(8, random.UnambiguousUpper)
+//
+// [String] takes a uint8 length and is limited to 255 characters. [StringN] and
+// [SecureStringN] accept an int length, and [WriteString] and [NewReader] stream random
+// characters without building the whole string in memory.
+//
+//	payload := random.StringN(1 << 20)
+//	_, err := random.WriteString(w, 10<<30, random.Hex)
+//
+// [String] picks characters byte by byte and is meant for ASCII charsets. [RuneString]
+// treats charsets as sequences of characters, counts length in characters and always returns
+// valid UTF-8. Multi-rune emoji such as flags and ZWJ sequences are kept intact.
+//
+//	code := random.RuneString(6, "АБВГДЕЖЗИКЛМН")
+//
+// Characters repeated across charsets are counted once, so random.String(8, Alphanumeric, Numeric)
+// does not double-weight digits. The [Charset] type adds set algebra ([Charset.Union],
+// [Charset.Minus], [Charset.Intersect]), range parsing with [ParseCharset] and validation.
+// [StringOf], [SecureStringOf], [StringOfFrom], [EntropyOf], [LengthForOf],
+// [SecureStringForEntropyOf] and [UniqueStringsOf] accept Charset values directly; the other
+// functions take cs.String().
+//
+//	cs := random.MustParseCharset("a-z0-9").Minus("0o1l")
+//	code := random.StringOf(8, cs)
+//
+// [SecureString] accepts the same arguments but uses crypto/rand with rejection sampling,
+// making it suitable for session IDs, invite links and API keys.
+//
+//	sessionID, err := random.SecureString(32)
+//	if err != nil {
+//	    return err
+//	}
+//
+// # Entropy
+//
+// [Entropy] reports how many bits a random string carries, and [LengthFor] returns the
+// length needed to reach a target. Both count distinct characters only.
+// [StringForEntropy], [SecureStringForEntropy], [OTPForEntropy] and [TokenForEntropy] take a
+// target entropy instead of a length and return [ErrEntropyTooHigh] for unreachable targets.
+//
+//	bits := random.Entropy(6, random.Numeric) // ~19.9 bits for a 6-digit OTP
+//	n := random.LengthFor(128, random.Hex)    // 32
+//	secret, err := random.SecureStringForEntropy(128)
+//	token, err := random.TokenForEntropy(128, random.EncodingBase64URL)
+//
+// # Unique Batches
+//
+// [UniqueStrings] generates a batch of guaranteed-distinct strings, such as promo codes.
+// It returns [ErrKeyspaceTooSmall] if the keyspace cannot hold the batch. Dense batches are
+// returned in full without an error; [UniqueStats].Dense warns that the codes would be easy
+// to guess, and [CollisionProbability] computes the same bound before generating.
+//
+//	codes, stats, err := random.UniqueStrings(100000, 10, random.UnambiguousUpper)
+//	if err == nil && stats.Dense {
+//	    // too dense: use a longer length
+//	}
+//
+// # Check Digits
+//
+// [CheckDigitCode] generates codes that end in a check character so that mistyped codes can
+// be rejected client-side with [ValidateCheckDigit]. Supported algorithms are [CheckLuhn],
+// [CheckDamm] and [CheckVerhoeff] for numeric codes and [CheckISO7064] (MOD 37,36) for
+// alphanumeric codes. [AppendCheckDigit] and [ComputeCheckDigit] work on existing payloads.
+//
+//	voucher := random.CheckDigitCode(12, random.CheckDamm)
+//	ok := random.ValidateCheckDigit(voucher, random.CheckDamm)
+//
+// # Voucher Batches
+//
+// [GenerateVouchers] mints batches of grouped codes such as "ACDE-FGHJ-KL" with crypto/rand.
+// Every candidate is checked against a [Store] so codes issued by earlier batches are never
+// repeated; collisions are retried up to [VoucherConfig].MaxRetries times before failing with
+// [ErrTooManyCollisions]. [MemoryStore] is the in-memory implementation; a database-backed
+// Store only needs an atomic insert-if-absent. [VoucherStats] reports attempts and collisions.
+//
+//	store := random.NewMemoryStore(issuedLastMonth...)
+//	codes, stats, err := random.GenerateVouchers(ctx, 1000, random.VoucherConfig{Prefix: "XMAS-"}, store)
+//
+// # Template-Based Generation
+//
+// [Pattern] fills a template where placeholders map to charsets: A (Uppercase), a (Lowercase),
+// 9 (Numeric), x (Hex), X (uppercase Hex) and * (Alphanumeric). Other characters are copied
+// as is, and a backslash escapes a placeholder. [RegisterPlaceholder] adds custom placeholders
+// but cannot redefine these defaults.
+//
+//	licenseKey := random.Pattern("XXXX-XXXX-9999")
+//	plate := random.Pattern("AA-999-a")
+//
+// [Regex] generates strings that match a Go regular expression, which is handy for
+// fixtures and fuzz data. Unbounded quantifiers are capped (10 extra repetitions by default).
+//
+//	s, err := random.Regex(`^[a-z]{3}-\d{4}$`)
+//
 // # Cryptographically Secure OTP Generation
 //
 // The [OTP] function generates cryptographically secure one-time passwords using crypto/rand.
@@ -40,6 +137,131 @@
 //	    return err
 //	}
 //
+// # HOTP
+//
+// [HOTP] computes RFC 4226 counter-based one-time passwords from a shared secret, so codes
+// do not need to be stored server-side. [HOTPOptions] select 6 to 10 digits, HMAC-SHA1
+// (default), SHA-256 or SHA-512, and a look-ahead window. [ValidateHOTP] checks a code
+// against the window and returns the counter to store for the next validation.
+//
+//	code, err := random.HOTP(secret, counter)
+//	next, ok, err := random.ValidateHOTP(secret, counter, input, random.HOTPOptions{Window: 5})
+//
+// # Tokens
+//
+// [Token] encodes N bytes of crypto/rand output as hex, base32 (RFC 4648 or Crockford),
+// base58 (Bitcoin alphabet), base62 or unpadded base64url. [EncodeToken], [DecodeToken]
+// and [ValidateToken] convert and check tokens.
+//
+//	token, err := random.Token(32, random.EncodingBase64URL)
+//	ok := random.ValidateToken(token, random.EncodingBase64URL, 32)
+//
+// # API Keys
+//
+// [APIKey] issues keys such as "sk_live_" + 30 random base62 characters + a 6-character
+// CRC32 checksum. [ParseAPIKey] and [VerifyAPIKey] check the prefix and checksum offline,
+// so typos are rejected before any database lookup.
+//
+//	key, err := random.APIKey("sk_live")
+//	ok := random.VerifyAPIKey(key, "sk_live")
+//
+// # UUIDs
+//
+// [NewUUIDv4] and [NewUUIDv7] generate RFC 9562 UUIDs with crypto/rand. Version 7 UUIDs
+// embed a millisecond timestamp and a counter, so UUIDs from one process sort in creation
+// order. [ParseUUID] and [UUIDFromBytes] read UUIDs back; [UUID] implements
+// encoding.TextMarshaler for JSON and database drivers.
+//
+//	id := random.NewUUIDv7()
+//	parsed, err := random.ParseUUID(id.String())
+//
+// # ULIDs
+//
+// [NewULID] generates a ULID: a 48-bit millisecond timestamp and 80 bits of crypto/rand
+// entropy in 26 Crockford base32 characters. [NewMonotonicULID] and [ULIDGenerator]
+// increment the entropy within the same millisecond so IDs sort in creation order.
+// [ParseULID] decodes ULIDs and [ULID.Time] extracts their timestamp.
+//
+//	id, err := random.NewMonotonicULID()
+//	created := id.Time()
+//
+// # KSUIDs
+//
+// [NewKSUID] generates a KSUID: a 32-bit timestamp in seconds since [KSUIDEpoch] and a
+// 128-bit crypto/rand payload in 27 base62 characters. [ParseKSUID] decodes KSUIDs from
+// other systems; [KSUID.Next] and [KSUID.Prev] step through IDs of the same second and
+// [SortKSUIDs] orders them by creation time.
+//
+//	id := random.NewKSUID()
+//	next := id.Next() // sorts right after id
+//
+// # Snowflake IDs
+//
+// [NewSnowflake] returns a generator of time-ordered int64 IDs for database primary keys:
+// a millisecond timestamp since a configurable epoch, a datacenter ID, a worker ID and a
+// per-millisecond sequence, with bit widths set by [SnowflakeConfig]. [Snowflake.Next] is
+// safe for concurrent use and returns [ErrClockMovedBackwards] if the clock is rolled back;
+// [Snowflake.Decode] splits an ID into its fields.
+//
+//	ids, err := random.NewSnowflake(random.SnowflakeConfig{DatacenterID: 1, WorkerID: 7})
+//	id, err := ids.Next()
+//
+// # Obfuscated Integer IDs
+//
+// [IDEncoder] turns database integers into short codes that do not look sequential and
+// decode back to the integers, in the style of Sqids and Hashids. The alphabet is shuffled
+// by a generator seeded from [IDEncoderConfig].Salt; codes can carry several numbers, be
+// padded to a minimum length and avoid blocklisted words. Codes obfuscate IDs but are not
+// encryption.
+//
+//	enc, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "secret", MinLength: 8})
+//	code, err := enc.Encode(1042)
+//	ids, err := enc.Decode(code) // [1042]
+//
+// # Short Codes
+//
+// [ShortCodec] encodes uint64 values as bijective base-N codes over any alphabet
+// (Alphanumeric by default): every string is the code of exactly one number, so codes are
+// as short as possible. [ShortCodec.Allocate] picks random unused codes of a given length,
+// recorded in a [Store], so short links cannot be enumerated.
+//
+//	codec, err := random.NewShortCodec()
+//	code, err := codec.Allocate(ctx, 7, store)
+//	id, err := codec.Decode(code)
+//
+// # NanoIDs
+//
+// [NanoID] generates IDs compatible with the JavaScript NanoID library: 21 characters from
+// the URL-safe [NanoIDAlphabet] by default, selected from crypto/rand bytes with NanoID's
+// unbiased mask algorithm. Any ASCII charset, including the package constants, can be used
+// as the alphabet.
+//
+//	id, err := random.NanoID(0)                   // "V1StGXR8_Z5jdHi6B-myT"
+//	id, err := random.NanoID(12, random.Numeric) // "482019374650"
+//
+// # Password Generation
+//
+// [Password] generates cryptographically secure passwords that follow a [PasswordPolicy]:
+// minimum counts per character class, excluded characters, and optional rules against
+// repeated or sequential characters. It returns [ErrUnsatisfiablePolicy] when the policy
+// is contradictory.
+//
+//	password, err := random.Password(random.PasswordPolicy{
+//	    Length:    16,
+//	    MinUpper:  1,
+//	    MinDigits: 2,
+//	    Exclude:   random.Ambiguous,
+//	})
+//
+// # Passphrase Generation
+//
+// [Passphrase] builds diceware-style passphrases with crypto/rand from the embedded EFF
+// wordlists ([EFFLargeWordlist] by default, [EFFShortWordlist]) or a custom list loaded
+// with [ParseWordlist]. [PassphraseOptions] control the separator, capitalization and an
+// appended digit or symbol.
+//
+//	phrase, err := random.Passphrase(6) // "cradle unshaved prism stoneware overrun sprang"
+//
 // # Weighted Random Selection
 //
 // The package provides several functions for performing weighted random selection from
@@ -89,6 +311,34 @@
 //	}
 //	selected := random.GetRandomMapItemWithPercent(drops)
 //
+// # Pluggable Sources
+//
+// Every generator draws randomness from a [Source], an interface with the same method set
+// as the math/rand/v2 Source. [New] wraps a Source in a [Generator] that exposes the
+// package functionality as methods. Stock sources are provided by [CryptoSource] (crypto/rand),
+// [NewPCGSource] and [NewChaCha8Source] (math/rand/v2).
+//
+//	g := random.New(random.NewPCGSource(1, 2))
+//	id := g.String(16)
+//	otp := g.OTP(8)
+//	drop := g.GetRandomMapItemWithPercent(drops)
+//
+// Go methods cannot have type parameters, so the generic selections are available as
+// functions that take the Generator explicitly:
+//
+//	selected := random.GetRandomWithProbabilitiesFrom(g, items, probabilities)
+//
+// [NewSeeded] returns a Generator whose output is reproducible for a given seed across runs
+// and Go versions, which makes failing simulations and golden-file tests replayable.
+//
+//	g := random.NewSeeded(42)
+//	code := g.String(8) // identical on every run
+//
+// The package-level functions keep working unchanged: [String] and the probability functions
+// use the automatically seeded math/rand/v2 generator, while [SecureString] and [OTP] use
+// [CryptoSource]. A Generator is safe for concurrent use only if its Source is;
+// the PCG and ChaCha8 sources are not.
+//
 // # Security Guidance
 //
 // WARNING: Use the appropriate function for your security requirements:
@@ -96,6 +346,17 @@
 //   - [String] uses math/rand and is NOT cryptographically secure.
 //     Use only for non-security purposes (IDs, test data, display strings).
 //
+//   - [SecureString] uses crypto/rand and IS cryptographically secure.
+//     Use for session IDs, invite links, API keys and other secrets.
+//
+//   - [Token] uses crypto/rand and IS cryptographically secure.
+//
+//   - [APIKey] uses crypto/rand and IS cryptographically secure.
+//
+//   - [Password] uses crypto/rand and IS cryptographically secure.
+//
+//   - [Passphrase] uses crypto/rand and IS cryptographically secure.
+//
 //   - [OTP] uses crypto/rand and IS cryptographically secure.
 //     Use for security-sensitive operations (passwords, tokens, MFA codes, session IDs).
 //
@@ -105,7 +366,8 @@
 //
 // # Error Handling
 //
-// The [OTP] function returns an error if the cryptographic random number generator fails.
+// The [OTP] and [SecureString] functions return an error for API stability; crypto/rand
+// never fails since Go 1.24, so the error is always nil.
 // All other functions return sensible defaults (nil, empty string) on invalid input rather
 // than panicking.
 package random
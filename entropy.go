@@ -0,0 +1,148 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrEntropyTooHigh is returned when no string or token of acceptable length carries the
+// requested entropy.
+var ErrEntropyTooHigh = errors.New("random: entropy target too high")
+
+// Entropy returns the entropy in bits of a random string of the given length whose
+// characters are picked uniformly from the provided character sets (Alphanumeric by default).
+// Characters repeated across charsets are counted once, matching how String and friends
+// pick characters. Returns 0 if length <= 0.
+//
+// Example:
+//
+//	random.Entropy(16)                 // ~95.3 bits for an alphanumeric string
+//	random.Entropy(32, random.Hex)     // 128 bits
+//	random.Entropy(6, random.Numeric)  // ~19.9 bits for a 6-digit OTP
+func Entropy(length int, charsets ...string) float64 {
+	if length <= 0 {
+		return 0
+	}
+	return float64(length) * bitsPerChar(charsets)
+}
+
+// maxEntropyLength bounds the strings and tokens generated for a target entropy. It is far
+// beyond any practical security level and keeps absurd targets from exhausting memory.
+const maxEntropyLength = 1 << 16
+
+// LengthFor returns the minimum length of a random string picked from the provided
+// character sets (Alphanumeric by default) that carries at least the given bits of entropy.
+// Returns 0 if bits <= 0, and math.MaxInt if no string of int length reaches the target,
+// which includes any positive target for a charset with a single distinct character.
+//
+// Example:
+//
+//	random.LengthFor(128)             // 22 alphanumeric characters
+//	random.LengthFor(128, random.Hex) // 32 hex characters
+func LengthFor(bits float64, charsets ...string) int {
+	return unitsFor(bits, bitsPerChar(charsets))
+}
+
+// StringForEntropy generates a random string long enough to carry at least the given bits
+// of entropy, using the provided character sets (Alphanumeric by default).
+// Returns ErrEntropyTooHigh if that takes more than 65536 characters, in particular for a
+// charset with a single distinct character, which carries no entropy at all.
+// This function is NOT cryptographically secure; use SecureStringForEntropy for secrets.
+//
+// Example:
+//
+//	id, err := random.StringForEntropy(64, random.Lowercase) // 14 lowercase letters
+func StringForEntropy(bits float64, charsets ...string) (string, error) {
+	return defaultGenerator.StringForEntropy(bits, charsets...)
+}
+
+// SecureStringForEntropy is like StringForEntropy but uses crypto/rand.
+//
+// Example:
+//
+//	token, err := random.SecureStringForEntropy(128) // 22 alphanumeric characters
+func SecureStringForEntropy(bits float64, charsets ...string) (string, error) {
+	return secureGenerator.StringForEntropy(bits, charsets...)
+}
+
+// StringForEntropy generates a random string carrying at least the given bits of entropy,
+// drawing randomness from the Generator's Source. See the package-level StringForEntropy
+// for details.
+func (g *Generator) StringForEntropy(bits float64, charsets ...string) (string, error) {
+	length, err := lengthForEntropy(bits, bitsPerChar(charsets), maxEntropyLength)
+	if err != nil {
+		return "", err
+	}
+	return g.StringN(length, charsets...), nil
+}
+
+// EntropyOf is like Entropy but accepts Charset values, and counts characters the way
+// StringOf picks them: as distinct grapheme clusters, so it is also exact for non-ASCII charsets.
+//
+// Example:
+//
+//	cs := random.MustParseCharset("а-я")
+//	bits := random.EntropyOf(8, cs) // 40 bits for 8 of 32 Cyrillic letters
+func EntropyOf[C ~string](length int, charsets ...C) float64 {
+	if length <= 0 {
+		return 0
+	}
+	return float64(length) * bitsPerGrapheme(charsetStrings(charsets))
+}
+
+// LengthForOf is like LengthFor but accepts Charset values and counts characters as
+// StringOf does. See EntropyOf.
+func LengthForOf[C ~string](bits float64, charsets ...C) int {
+	return unitsFor(bits, bitsPerGrapheme(charsetStrings(charsets)))
+}
+
+// SecureStringForEntropyOf is like SecureStringForEntropy but accepts Charset values and
+// generates the string with SecureStringOf.
+//
+// Example:
+//
+//	token, err := random.SecureStringForEntropyOf(128, random.Charset(random.Alphanumeric).WithoutAmbiguous())
+func SecureStringForEntropyOf[C ~string](bits float64, charsets ...C) (string, error) {
+	strs := charsetStrings(charsets)
+	length, err := lengthForEntropy(bits, bitsPerGrapheme(strs), maxEntropyLength)
+	if err != nil {
+		return "", err
+	}
+	return secureGenerator.RuneString(length, strs...), nil
+}
+
+// unitsFor returns how many units of perUnit bits each carry at least the given bits,
+// 0 if bits <= 0, and math.MaxInt if the count does not fit in an int. A positive target
+// with perUnit == 0 divides to +Inf and therefore yields math.MaxInt.
+func unitsFor(bits, perUnit float64) int {
+	if !(bits > 0) {
+		return 0
+	}
+	// The epsilon keeps exact multiples such as 128 bits of Hex from rounding up
+	units := math.Ceil(bits/perUnit - 1e-9)
+	if units >= math.MaxInt {
+		// Converting a float64 beyond the int range is implementation-defined
+		return math.MaxInt
+	}
+	return int(units)
+}
+
+// lengthForEntropy is unitsFor that returns ErrEntropyTooHigh if more than limit units are needed.
+func lengthForEntropy(bits, perUnit float64, limit int) (int, error) {
+	length := unitsFor(bits, perUnit)
+	if length > limit {
+		return 0, fmt.Errorf("%w: %g bits requested, at most %g possible", ErrEntropyTooHigh, bits, float64(limit)*perUnit)
+	}
+	return length, nil
+}
+
+// bitsPerChar returns log2 of the number of distinct characters in the charsets.
+func bitsPerChar(charsets []string) float64 {
+	return math.Log2(float64(len(joinCharsets(charsets))))
+}
+
+// bitsPerGrapheme returns log2 of the number of distinct grapheme clusters in the charsets.
+func bitsPerGrapheme(charsets []string) float64 {
+	return math.Log2(float64(len(charsetGraphemes(charsets))))
+}
@@ -0,0 +1,124 @@
+package random_test
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestEntropy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		length   int
+		charsets []string
+		want     float64
+	}{
+		{"default alphanumeric", 16, nil, 16 * math.Log2(62)},
+		{"hex", 32, []string{random.Hex}, 128},
+		{"otp", 6, []string{random.Numeric}, 6 * math.Log2(10)},
+		{"deduplicated charsets", 10, []string{random.Alphanumeric, random.Numeric}, 10 * math.Log2(62)},
+		{"single character", 10, []string{"a"}, 0},
+		{"zero length", 0, nil, 0},
+		{"negative length", -1, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tt.want, random.Entropy(tt.length, tt.charsets...), 1e-9)
+		})
+	}
+}
+
+func TestLengthFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		bits     float64
+		charsets []string
+		want     int
+	}{
+		{"default alphanumeric", 128, nil, 22},
+		{"exact hex multiple", 128, []string{random.Hex}, 32},
+		{"rounds up", 129, []string{random.Hex}, 33},
+		{"numeric", 20, []string{random.Numeric}, 7},
+		{"deduplicated charsets", 128, []string{random.Hex, random.Numeric}, 32},
+		{"single character", 10, []string{"aaaa"}, math.MaxInt},
+		{"zero bits", 0, nil, 0},
+		{"NaN bits", math.NaN(), nil, 0},
+		{"beyond int range", 1e30, nil, math.MaxInt},
+		{"infinite bits", math.Inf(1), []string{random.Hex}, math.MaxInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := random.LengthFor(tt.bits, tt.charsets...)
+			assert.Equal(t, tt.want, got)
+			if got > 0 && got < math.MaxInt {
+				assert.GreaterOrEqual(t, random.Entropy(got, tt.charsets...), tt.bits-1e-9)
+			}
+		})
+	}
+}
+
+func TestStringForEntropy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := random.StringForEntropy(128)
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^[a-zA-Z0-9]{22}$`), got)
+	})
+
+	t.Run("secure", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := random.SecureStringForEntropy(256, random.Hex)
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{64}$`), got)
+	})
+
+	t.Run("zero bits", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := random.StringForEntropy(0)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("unreachable target", func(t *testing.T) {
+		t.Parallel()
+
+		for _, bits := range []float64{1e30, 1e12, math.Inf(1)} {
+			_, err := random.SecureStringForEntropy(bits)
+			require.ErrorIs(t, err, random.ErrEntropyTooHigh, bits)
+
+			_, err = random.StringForEntropy(bits)
+			require.ErrorIs(t, err, random.ErrEntropyTooHigh, bits)
+		}
+	})
+
+	t.Run("single-character charset carries no entropy", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := random.SecureStringForEntropy(128, "a")
+		require.ErrorIs(t, err, random.ErrEntropyTooHigh)
+		assert.Empty(t, got)
+
+		_, err = random.NewSeeded(1).StringForEntropy(1, "aaaa")
+		require.ErrorIs(t, err, random.ErrEntropyTooHigh)
+	})
+}
@@ -0,0 +1,172 @@
+package random
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+)
+
+// ErrInvalidHOTPOptions is returned for an empty secret, unsupported digit counts or
+// unknown hash algorithms.
+var ErrInvalidHOTPOptions = errors.New("random: invalid HOTP options")
+
+const (
+	defaultHOTPDigits = 6
+	minHOTPDigits     = 6
+	maxHOTPDigits     = 10
+)
+
+// HOTPAlgorithm selects the HMAC hash function of HOTP.
+type HOTPAlgorithm int
+
+const (
+	// HOTPSHA1 is HMAC-SHA1, the RFC 4226 default understood by every authenticator app.
+	HOTPSHA1 HOTPAlgorithm = iota
+	// HOTPSHA256 is HMAC-SHA256.
+	HOTPSHA256
+	// HOTPSHA512 is HMAC-SHA512.
+	HOTPSHA512
+)
+
+// String returns the name of the algorithm as used in otpauth:// URIs.
+func (a HOTPAlgorithm) String() string {
+	switch a {
+	case HOTPSHA1:
+		return "SHA1"
+	case HOTPSHA256:
+		return "SHA256"
+	case HOTPSHA512:
+		return "SHA512"
+	}
+	return fmt.Sprintf("HOTPAlgorithm(%d)", int(a))
+}
+
+// HOTPOptions configures HOTP and ValidateHOTP.
+type HOTPOptions struct {
+	// Digits is the code length, from 6 to 10. Defaults to 6.
+	Digits int
+	// Algorithm is the HMAC hash function. Defaults to HOTPSHA1.
+	Algorithm HOTPAlgorithm
+	// Window is the number of counter values after the expected one that ValidateHOTP
+	// also accepts, to resynchronize with clients that generated codes without using them.
+	// Defaults to 0, which only accepts the expected counter.
+	Window int
+}
+
+// HOTP computes the RFC 4226 HMAC-based one-time password for secret and counter.
+// Unlike OTP, the code does not need to be stored: the server recomputes it from the
+// shared secret and its copy of the counter.
+// Returns ErrInvalidHOTPOptions for an empty secret or invalid options.
+//
+// Example:
+//
+//	secret, _ := random.DecodeToken(base32Secret, random.EncodingBase32)
+//	code, err := random.HOTP(secret, counter)                                  // "755224"
+//	code, err := random.HOTP(secret, counter, random.HOTPOptions{Digits: 8})   // "84755224"
+func HOTP(secret []byte, counter uint64, opts ...HOTPOptions) (string, error) {
+	opt, newHash, err := hotpOptions(secret, opts)
+	if err != nil {
+		return "", err
+	}
+	return hotp(secret, counter, opt.Digits, newHash), nil
+}
+
+// ValidateHOTP reports whether code is the HOTP for secret at counter or at one of the
+// following Window counters. On success it returns the counter to store for the next
+// validation, one past the matching counter, so each code is accepted only once.
+// Codes are compared in constant time.
+// Returns ErrInvalidHOTPOptions for an empty secret or invalid options.
+//
+// Example:
+//
+//	next, ok, err := random.ValidateHOTP(secret, user.Counter, input, random.HOTPOptions{Window: 5})
+//	if err != nil {
+//	    return err
+//	}
+//	if ok {
+//	    user.Counter = next
+//	}
+func ValidateHOTP(secret []byte, counter uint64, code string, opts ...HOTPOptions) (next uint64, ok bool, err error) {
+	opt, newHash, err := hotpOptions(secret, opts)
+	if err != nil {
+		return counter, false, err
+	}
+	if len(code) != opt.Digits {
+		return counter, false, nil
+	}
+
+	for i := 0; i <= max(opt.Window, 0); i++ {
+		c := counter + uint64(i)
+		if c < counter {
+			// Counter wrapped around
+			break
+		}
+		want := hotp(secret, c, opt.Digits, newHash)
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return c + 1, true, nil
+		}
+	}
+	return counter, false, nil
+}
+
+// hotpOptions applies defaults to opts and validates them together with secret.
+func hotpOptions(secret []byte, opts []HOTPOptions) (HOTPOptions, func() hash.Hash, error) {
+	var opt HOTPOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Digits == 0 {
+		opt.Digits = defaultHOTPDigits
+	}
+
+	if len(secret) == 0 {
+		return opt, nil, fmt.Errorf("%w: empty secret", ErrInvalidHOTPOptions)
+	}
+	if opt.Digits < minHOTPDigits || opt.Digits > maxHOTPDigits {
+		return opt, nil, fmt.Errorf("%w: %d digits, want %d to %d", ErrInvalidHOTPOptions, opt.Digits, minHOTPDigits, maxHOTPDigits)
+	}
+
+	var newHash func() hash.Hash
+	switch opt.Algorithm {
+	case HOTPSHA1:
+		newHash = sha1.New
+	case HOTPSHA256:
+		newHash = sha256.New
+	case HOTPSHA512:
+		newHash = sha512.New
+	default:
+		return opt, nil, fmt.Errorf("%w: unknown algorithm %s", ErrInvalidHOTPOptions, opt.Algorithm)
+	}
+	return opt, newHash, nil
+}
+
+// hotp implements the RFC 4226 algorithm: HMAC of the big-endian counter, dynamic
+// truncation to 31 bits, and reduction to the requested number of decimal digits.
+func hotp(secret []byte, counter uint64, digits int, newHash func() hash.Hash) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(newHash, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := uint64(binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff)
+
+	modulus := uint64(1)
+	for range digits {
+		modulus *= 10
+	}
+	code := strconv.FormatUint(value%modulus, 10)
+	for len(code) < digits {
+		code = "0" + code
+	}
+	return code
+}
@@ -0,0 +1,166 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+// rfc4226Secret is the shared secret of the RFC 4226 and RFC 6238 test vectors.
+const rfc4226Secret = "12345678901234567890"
+
+func TestHOTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RFC 4226 test vectors", func(t *testing.T) {
+		t.Parallel()
+
+		want := []string{
+			"755224", "287082", "359152", "969429", "338314",
+			"254676", "287922", "162583", "399871", "520489",
+		}
+		for counter, code := range want {
+			got, err := random.HOTP([]byte(rfc4226Secret), uint64(counter))
+			require.NoError(t, err)
+			assert.Equal(t, code, got, "counter %d", counter)
+		}
+	})
+
+	t.Run("digits", func(t *testing.T) {
+		t.Parallel()
+
+		// Truncated value for counter 0 is 1284755224 (RFC 4226 appendix D)
+		for digits, code := range map[int]string{
+			6:  "755224",
+			7:  "4755224",
+			8:  "84755224",
+			10: "1284755224",
+		} {
+			got, err := random.HOTP([]byte(rfc4226Secret), 0, random.HOTPOptions{Digits: digits})
+			require.NoError(t, err)
+			assert.Equal(t, code, got)
+		}
+	})
+
+	t.Run("hash algorithms", func(t *testing.T) {
+		t.Parallel()
+
+		// RFC 6238 test vectors for T = 59s, i.e. counter 1, with 8 digits
+		for _, tc := range []struct {
+			alg    random.HOTPAlgorithm
+			secret string
+			code   string
+		}{
+			{random.HOTPSHA1, rfc4226Secret, "94287082"},
+			{random.HOTPSHA256, "12345678901234567890123456789012", "46119246"},
+			{random.HOTPSHA512, "1234567890123456789012345678901234567890123456789012345678901234", "90693936"},
+		} {
+			got, err := random.HOTP([]byte(tc.secret), 1, random.HOTPOptions{Digits: 8, Algorithm: tc.alg})
+			require.NoError(t, err)
+			assert.Equal(t, tc.code, got, tc.alg.String())
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		t.Parallel()
+
+		for name, tc := range map[string]struct {
+			secret []byte
+			opts   random.HOTPOptions
+		}{
+			"empty secret":      {nil, random.HOTPOptions{}},
+			"too few digits":    {[]byte(rfc4226Secret), random.HOTPOptions{Digits: 5}},
+			"too many digits":   {[]byte(rfc4226Secret), random.HOTPOptions{Digits: 11}},
+			"unknown algorithm": {[]byte(rfc4226Secret), random.HOTPOptions{Algorithm: 7}},
+		} {
+			_, err := random.HOTP(tc.secret, 0, tc.opts)
+			require.ErrorIs(t, err, random.ErrInvalidHOTPOptions, name)
+
+			_, _, err = random.ValidateHOTP(tc.secret, 0, "755224", tc.opts)
+			require.ErrorIs(t, err, random.ErrInvalidHOTPOptions, name)
+		}
+	})
+}
+
+func TestValidateHOTP(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte(rfc4226Secret)
+
+	t.Run("expected counter", func(t *testing.T) {
+		t.Parallel()
+
+		next, ok, err := random.ValidateHOTP(secret, 3, "969429")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(4), next)
+
+		// The same code is rejected once the counter has moved on
+		next, ok, err = random.ValidateHOTP(secret, next, "969429")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, uint64(4), next)
+	})
+
+	t.Run("look-ahead window", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok, err := random.ValidateHOTP(secret, 0, "162583")
+		require.NoError(t, err)
+		assert.False(t, ok, "counter 7 is outside the default window")
+
+		next, ok, err := random.ValidateHOTP(secret, 0, "162583", random.HOTPOptions{Window: 7})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(8), next)
+
+		_, ok, err = random.ValidateHOTP(secret, 0, "162583", random.HOTPOptions{Window: 6})
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("wrong codes", func(t *testing.T) {
+		t.Parallel()
+
+		for _, code := range []string{"", "000000", "75522", "7552240", "abcdef"} {
+			next, ok, err := random.ValidateHOTP(secret, 0, code, random.HOTPOptions{Window: 3})
+			require.NoError(t, err)
+			assert.False(t, ok, code)
+			assert.Equal(t, uint64(0), next)
+		}
+	})
+
+	t.Run("counter near overflow", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := random.HOTP(secret, math.MaxUint64)
+		require.NoError(t, err)
+
+		_, ok, err := random.ValidateHOTP(secret, math.MaxUint64-1, code, random.HOTPOptions{Window: 10})
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("round trip with a random secret", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := random.Token(20, random.EncodingBase32)
+		require.NoError(t, err)
+		secret, err := random.DecodeToken(token, random.EncodingBase32)
+		require.NoError(t, err)
+
+		opts := random.HOTPOptions{Digits: 8, Algorithm: random.HOTPSHA256, Window: 2}
+		code, err := random.HOTP(secret, 42, opts)
+		require.NoError(t, err)
+		require.Regexp(t, `^[0-9]{8}$`, code)
+
+		next, ok, err := random.ValidateHOTP(secret, 41, code, opts)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(43), next)
+	})
+}
@@ -0,0 +1,256 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+var (
+	// ErrInvalidID is returned when a code was not produced by the IDEncoder decoding it.
+	ErrInvalidID = errors.New("random: invalid ID")
+	// ErrBlockedID is returned when every candidate code for the given numbers contains a
+	// blocklisted word.
+	ErrBlockedID = errors.New("random: every candidate ID is blocked")
+)
+
+const (
+	// minIDAlphabetLength leaves room for a prefix, a separator and at least one digit.
+	minIDAlphabetLength = 3
+	// maxIDMinLength bounds the padding of short codes.
+	maxIDMinLength = 255
+)
+
+// IDEncoderConfig configures an IDEncoder.
+type IDEncoderConfig struct {
+	// Salt selects the permutation of the alphabet. Encoders with different salts produce
+	// different codes for the same numbers; keep it private to make codes harder to guess.
+	Salt string
+	// Alphabet is the ASCII character set codes are built from. Repeated characters are
+	// ignored. Defaults to Alphanumeric.
+	Alphabet string
+	// MinLength pads shorter codes up to this length, at most 255.
+	MinLength int
+	// Blocklist lists words that must not appear in codes, matched case-insensitively.
+	Blocklist []string
+}
+
+// IDEncoder reversibly encodes integers, such as database IDs, into short codes that do not
+// look sequential, in the style of Sqids and Hashids. It is safe for concurrent use.
+//
+// The codes obfuscate IDs but are NOT encryption: anyone with enough codes and effort can
+// recover the salted alphabet. Do not rely on them for access control.
+type IDEncoder struct {
+	alphabet  []byte
+	minLength int
+	blocklist []string
+}
+
+// NewIDEncoder returns an IDEncoder for the given configuration. The alphabet is shuffled
+// with a generator seeded from the salt, so the same configuration always yields the same codes.
+// Returns ErrInvalidCharset if the alphabet is not ASCII or has fewer than 3 distinct
+// characters, and an error if MinLength is out of range.
+//
+// Example:
+//
+//	enc, err := random.NewIDEncoder(random.IDEncoderConfig{
+//	    Salt:      os.Getenv("ID_SALT"),
+//	    MinLength: 8,
+//	})
+//	code, err := enc.Encode(1042)  // "k3Vd9QxA"
+//	ids, err := enc.Decode(code)   // [1042]
+func NewIDEncoder(cfg IDEncoderConfig) (*IDEncoder, error) {
+	alphabet := []byte(joinCharsets([]string{cfg.Alphabet}))
+	if len(alphabet) < minIDAlphabetLength {
+		return nil, fmt.Errorf("%w: ID alphabet needs at least %d characters", ErrInvalidCharset, minIDAlphabetLength)
+	}
+	for _, c := range alphabet {
+		if c >= 0x80 {
+			return nil, fmt.Errorf("%w: ID alphabet must be ASCII", ErrInvalidCharset)
+		}
+	}
+	if cfg.MinLength < 0 || cfg.MinLength > maxIDMinLength {
+		return nil, fmt.Errorf("random: ID min length %d out of range [0, %d]", cfg.MinLength, maxIDMinLength)
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(cfg.Salt))
+	NewSeeded(h.Sum64()).Shuffle(len(alphabet), func(i, j int) {
+		alphabet[i], alphabet[j] = alphabet[j], alphabet[i]
+	})
+
+	var blocklist []string
+	for _, word := range cfg.Blocklist {
+		if word != "" {
+			blocklist = append(blocklist, strings.ToLower(word))
+		}
+	}
+
+	return &IDEncoder{
+		alphabet:  alphabet,
+		minLength: cfg.MinLength,
+		blocklist: blocklist,
+	}, nil
+}
+
+// Encode returns the code for one or more numbers. Returns an empty string if no numbers
+// are given, and ErrBlockedID in the unlikely case that every candidate code is blocklisted.
+func (e *IDEncoder) Encode(numbers ...uint64) (string, error) {
+	if len(numbers) == 0 {
+		return "", nil
+	}
+	// Each increment rotates the alphabet to a new starting offset, giving a different code
+	for increment := range len(e.alphabet) {
+		id := e.encode(numbers, increment)
+		if !e.isBlocked(id) {
+			return id, nil
+		}
+	}
+	return "", ErrBlockedID
+}
+
+// Decode returns the numbers encoded in code. Returns ErrInvalidID if code contains
+// characters outside the alphabet, overflows uint64, or is not exactly the code Encode
+// produces for the decoded numbers.
+func (e *IDEncoder) Decode(code string) ([]uint64, error) {
+	if code == "" {
+		return nil, fmt.Errorf("%w: empty code", ErrInvalidID)
+	}
+	for i := 0; i < len(code); i++ {
+		if !e.contains(code[i]) {
+			return nil, fmt.Errorf("%w: illegal character %q", ErrInvalidID, code[i])
+		}
+	}
+
+	alphabet := e.rotated(strings.IndexByte(string(e.alphabet), code[0]))
+	rest := code[1:]
+
+	var numbers []uint64
+	for rest != "" {
+		separator := alphabet[0]
+		chunk, tail, found := strings.Cut(rest, string(separator))
+		if chunk == "" {
+			// A separator directly after another one starts the padding
+			break
+		}
+		n, ok := idToNumber(chunk, alphabet[1:])
+		if !ok {
+			return nil, fmt.Errorf("%w: number overflows uint64", ErrInvalidID)
+		}
+		numbers = append(numbers, n)
+		if found {
+			idShuffle(alphabet)
+		}
+		rest = tail
+	}
+
+	// Codes that decode but differ from the canonical encoding are rejected, so every
+	// number sequence has exactly one valid code
+	if canonical, err := e.Encode(numbers...); err != nil || canonical != code {
+		return nil, fmt.Errorf("%w: non-canonical code", ErrInvalidID)
+	}
+	return numbers, nil
+}
+
+// encode builds the code for numbers with the given alphabet offset increment.
+func (e *IDEncoder) encode(numbers []uint64, increment int) string {
+	n := uint64(len(e.alphabet))
+	offset := uint64(len(numbers))
+	for i, num := range numbers {
+		offset += uint64(e.alphabet[num%n]) + uint64(i)
+	}
+	alphabet := e.rotated(int((offset + uint64(increment)) % n))
+
+	var sb strings.Builder
+	sb.WriteByte(e.alphabet[(offset+uint64(increment))%n])
+	for i, num := range numbers {
+		sb.WriteString(idFromNumber(num, alphabet[1:]))
+		if i < len(numbers)-1 {
+			sb.WriteByte(alphabet[0])
+			idShuffle(alphabet)
+		}
+	}
+
+	if sb.Len() < e.minLength {
+		sb.WriteByte(alphabet[0])
+		for sb.Len() < e.minLength {
+			idShuffle(alphabet)
+			sb.Write(alphabet[:min(e.minLength-sb.Len(), len(alphabet))])
+		}
+	}
+	return sb.String()
+}
+
+// rotated returns a copy of the alphabet starting at offset, reversed, so that the prefix
+// character at offset is never used as the first separator.
+func (e *IDEncoder) rotated(offset int) []byte {
+	n := len(e.alphabet)
+	alphabet := make([]byte, n)
+	for i := range alphabet {
+		alphabet[n-1-i] = e.alphabet[(offset+i)%n]
+	}
+	return alphabet
+}
+
+// contains reports whether c is in the alphabet.
+func (e *IDEncoder) contains(c byte) bool {
+	return strings.IndexByte(string(e.alphabet), c) >= 0
+}
+
+// isBlocked reports whether id contains a blocklisted word.
+func (e *IDEncoder) isBlocked(id string) bool {
+	if len(e.blocklist) == 0 {
+		return false
+	}
+	id = strings.ToLower(id)
+	for _, word := range e.blocklist {
+		if strings.Contains(id, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// idShuffle deterministically permutes alphabet in place, so each number in a code is
+// written with a different digit alphabet.
+func idShuffle(alphabet []byte) {
+	n := len(alphabet)
+	for i, j := 0, n-1; j > 0; i, j = i+1, j-1 {
+		r := (i*j + int(alphabet[i]) + int(alphabet[j])) % n
+		alphabet[i], alphabet[r] = alphabet[r], alphabet[i]
+	}
+}
+
+// idFromNumber writes num in base len(digits), most significant digit first.
+func idFromNumber(num uint64, digits []byte) string {
+	base := uint64(len(digits))
+	var buf [64]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = digits[num%base]
+		num /= base
+		if num == 0 {
+			return string(buf[i:])
+		}
+	}
+}
+
+// idToNumber is the inverse of idFromNumber; ok is false on overflow.
+func idToNumber(s string, digits []byte) (num uint64, ok bool) {
+	base := uint64(len(digits))
+	for i := 0; i < len(s); i++ {
+		hi, lo := bits.Mul64(num, base)
+		if hi != 0 {
+			return 0, false
+		}
+		var carry uint64
+		num, carry = bits.Add64(lo, uint64(strings.IndexByte(string(digits), s[i])), 0)
+		if carry != 0 {
+			return 0, false
+		}
+	}
+	return num, true
+}
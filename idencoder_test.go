@@ -0,0 +1,200 @@
+package random_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestIDEncoder(t *testing.T) {
+	t.Parallel()
+
+	enc, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "pepper"})
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		for _, numbers := range [][]uint64{
+			{0},
+			{1},
+			{42},
+			{1 << 32},
+			{math.MaxUint64},
+			{1, 2, 3},
+			{0, 0, 0, 0},
+			{math.MaxUint64, 0, 7},
+		} {
+			code, err := enc.Encode(numbers...)
+			require.NoError(t, err)
+			require.Regexp(t, `^[0-9A-Za-z]+$`, code)
+
+			decoded, err := enc.Decode(code)
+			require.NoError(t, err, code)
+			assert.Equal(t, numbers, decoded, code)
+		}
+	})
+
+	t.Run("deterministic per salt", func(t *testing.T) {
+		t.Parallel()
+
+		same, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "pepper"})
+		require.NoError(t, err)
+		other, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "salt"})
+		require.NoError(t, err)
+
+		a, err := enc.Encode(1042)
+		require.NoError(t, err)
+		b, err := same.Encode(1042)
+		require.NoError(t, err)
+		c, err := other.Encode(1042)
+		require.NoError(t, err)
+
+		assert.Equal(t, a, b)
+		assert.NotEqual(t, a, c)
+
+		// A code from another salt is rejected or decodes to different numbers
+		if decoded, err := other.Decode(a); err == nil {
+			assert.NotEqual(t, []uint64{1042}, decoded)
+		}
+	})
+
+	t.Run("consecutive numbers do not look sequential", func(t *testing.T) {
+		t.Parallel()
+
+		codes := make(map[string]bool)
+		prefixes := make(map[byte]bool)
+		for n := range uint64(100) {
+			code, err := enc.Encode(n)
+			require.NoError(t, err)
+			codes[code] = true
+			prefixes[code[0]] = true
+		}
+		assert.Len(t, codes, 100)
+		assert.Greater(t, len(prefixes), 20)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := enc.Encode()
+		require.NoError(t, err)
+		assert.Empty(t, code)
+
+		_, err = enc.Decode("")
+		require.ErrorIs(t, err, random.ErrInvalidID)
+	})
+
+	t.Run("invalid codes", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := enc.Encode(123456789)
+		require.NoError(t, err)
+
+		for _, invalid := range []string{
+			code + "-",
+			"ab!c",
+			strings.Repeat("z", 40),
+		} {
+			_, err := enc.Decode(invalid)
+			require.ErrorIs(t, err, random.ErrInvalidID, invalid)
+		}
+
+		// Only the canonical code of a number sequence decodes
+		for i := range len(code) {
+			for _, c := range []byte(random.Alphanumeric) {
+				if c == code[i] {
+					continue
+				}
+				mutated := code[:i] + string(c) + code[i+1:]
+				if numbers, err := enc.Decode(mutated); err == nil {
+					again, err := enc.Encode(numbers...)
+					require.NoError(t, err)
+					require.Equal(t, mutated, again)
+				}
+			}
+		}
+	})
+}
+
+func TestIDEncoder_MinLength(t *testing.T) {
+	t.Parallel()
+
+	enc, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "s", MinLength: 10})
+	require.NoError(t, err)
+
+	for _, numbers := range [][]uint64{{0}, {1, 2}, {math.MaxUint64, math.MaxUint64}} {
+		code, err := enc.Encode(numbers...)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(code), 10)
+
+		decoded, err := enc.Decode(code)
+		require.NoError(t, err)
+		assert.Equal(t, numbers, decoded)
+	}
+
+	long, err := random.NewIDEncoder(random.IDEncoderConfig{Alphabet: "abc", MinLength: 100})
+	require.NoError(t, err)
+	code, err := long.Encode(5)
+	require.NoError(t, err)
+	assert.Len(t, code, 100)
+	decoded, err := long.Decode(code)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5}, decoded)
+}
+
+func TestIDEncoder_Blocklist(t *testing.T) {
+	t.Parallel()
+
+	plain, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "b"})
+	require.NoError(t, err)
+	code, err := plain.Encode(1000000)
+	require.NoError(t, err)
+
+	blocked := strings.ToUpper(code[1:3])
+	enc, err := random.NewIDEncoder(random.IDEncoderConfig{Salt: "b", Blocklist: []string{blocked}})
+	require.NoError(t, err)
+
+	other, err := enc.Encode(1000000)
+	require.NoError(t, err)
+	assert.NotEqual(t, code, other)
+	assert.NotContains(t, strings.ToLower(other), strings.ToLower(blocked))
+
+	decoded, err := enc.Decode(other)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1000000}, decoded)
+
+	// The blocked code is no longer accepted
+	_, err = enc.Decode(code)
+	require.ErrorIs(t, err, random.ErrInvalidID)
+
+	t.Run("exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		enc, err := random.NewIDEncoder(random.IDEncoderConfig{Alphabet: "abc", Blocklist: []string{"a", "b", "c"}})
+		require.NoError(t, err)
+		_, err = enc.Encode(1)
+		require.ErrorIs(t, err, random.ErrBlockedID)
+	})
+}
+
+func TestNewIDEncoder_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := random.NewIDEncoder(random.IDEncoderConfig{Alphabet: "aab"})
+	require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+	_, err = random.NewIDEncoder(random.IDEncoderConfig{Alphabet: "абвг"})
+	require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+	_, err = random.NewIDEncoder(random.IDEncoderConfig{MinLength: 256})
+	require.Error(t, err)
+
+	_, err = random.NewIDEncoder(random.IDEncoderConfig{MinLength: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,175 @@
+package random
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidKSUID is returned when a KSUID cannot be parsed.
+var ErrInvalidKSUID = errors.New("random: invalid KSUID")
+
+const (
+	// KSUIDEpoch is the Unix time in seconds that KSUID timestamps count from (2014-05-13).
+	KSUIDEpoch = 1400000000
+	// ksuidEncodedLength is the length of the base62 text form of a KSUID.
+	ksuidEncodedLength = 27
+	// ksuidTimestampLength is the number of timestamp bytes preceding the payload.
+	ksuidTimestampLength = 4
+)
+
+// KSUID is a K-Sortable Unique IDentifier: a 32-bit timestamp in seconds since KSUIDEpoch
+// followed by a 128-bit random payload, written as 27 base62 characters.
+// KSUIDs sort by creation time, both as bytes and as strings.
+type KSUID [20]byte
+
+// NilKSUID is the all-zero KSUID.
+var NilKSUID KSUID
+
+// NewKSUID returns a KSUID for the current time with a 128-bit crypto/rand payload.
+//
+// Example:
+//
+//	id := random.NewKSUID() // "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+func NewKSUID() KSUID {
+	return secureGenerator.NewKSUID(time.Now())
+}
+
+// NewKSUID returns a KSUID for t, drawing the payload from the Generator's Source.
+// Times outside the 136 years representable after KSUIDEpoch are clamped.
+func (g *Generator) NewKSUID(t time.Time) KSUID {
+	ts := min(max(t.Unix()-KSUIDEpoch, 0), 1<<32-1)
+
+	var id KSUID
+	binary.BigEndian.PutUint32(id[:ksuidTimestampLength], uint32(ts))
+	g.Read(id[ksuidTimestampLength:])
+	return id
+}
+
+// ParseKSUID parses the 27-character base62 form of a KSUID.
+// Returns ErrInvalidKSUID for malformed input or values above "aWgEPTl1tmebfsQzFP4bxwgy80V".
+//
+// Example:
+//
+//	id, err := random.ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+//	if err != nil {
+//	    return err
+//	}
+//	created := id.Time()
+func ParseKSUID(s string) (KSUID, error) {
+	if len(s) != ksuidEncodedLength {
+		return NilKSUID, fmt.Errorf("%w: unexpected length %d", ErrInvalidKSUID, len(s))
+	}
+	b, err := decodeBaseN(s, base62Alphabet)
+	if err != nil {
+		return NilKSUID, fmt.Errorf("%w: %v", ErrInvalidKSUID, err)
+	}
+	// Leading '0' characters decode to zero bytes; only the numeric value matters
+	b = bytes.TrimLeft(b, "\x00")
+
+	var id KSUID
+	if len(b) > len(id) {
+		return NilKSUID, fmt.Errorf("%w: value overflows 160 bits", ErrInvalidKSUID)
+	}
+	copy(id[len(id)-len(b):], b)
+	return id, nil
+}
+
+// KSUIDFromBytes returns the KSUID stored in b, which must be exactly 20 bytes long.
+func KSUIDFromBytes(b []byte) (KSUID, error) {
+	var id KSUID
+	if len(b) != len(id) {
+		return NilKSUID, fmt.Errorf("%w: got %d bytes, want 20", ErrInvalidKSUID, len(b))
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// SortKSUIDs sorts ids in ascending order, which is creation order at one-second resolution.
+func SortKSUIDs(ids []KSUID) {
+	slices.SortFunc(ids, KSUID.Compare)
+}
+
+// String returns the 27-character base62 form of the KSUID, left-padded with '0'.
+func (id KSUID) String() string {
+	digits := encodeBaseN(bytes.TrimLeft(id[:], "\x00"), base62Alphabet)
+	return strings.Repeat(base62Alphabet[:1], ksuidEncodedLength-len(digits)) + digits
+}
+
+// Bytes returns a copy of the 20 bytes of the KSUID.
+func (id KSUID) Bytes() []byte {
+	return id[:]
+}
+
+// Timestamp returns the raw timestamp: seconds since KSUIDEpoch.
+func (id KSUID) Timestamp() uint32 {
+	return binary.BigEndian.Uint32(id[:ksuidTimestampLength])
+}
+
+// Time returns the creation time of the KSUID with one-second precision.
+func (id KSUID) Time() time.Time {
+	return time.Unix(int64(id.Timestamp())+KSUIDEpoch, 0)
+}
+
+// Payload returns a copy of the 16-byte random payload.
+func (id KSUID) Payload() []byte {
+	return id[ksuidTimestampLength:]
+}
+
+// IsNil reports whether id is the all-zero KSUID.
+func (id KSUID) IsNil() bool {
+	return id == NilKSUID
+}
+
+// Compare returns -1, 0 or +1 depending on whether id sorts before, equal to or after other.
+func (id KSUID) Compare(other KSUID) int {
+	return bytes.Compare(id[:], other[:])
+}
+
+// Next returns the KSUID that immediately follows id: the payload incremented by one,
+// carrying into the timestamp on overflow. It generates ordered KSUIDs within the same
+// second from a single random one.
+//
+// Example:
+//
+//	first := random.NewKSUID()
+//	second := first.Next() // same second, sorts right after first
+func (id KSUID) Next() KSUID {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]++
+		if id[i] != 0 {
+			break
+		}
+	}
+	return id
+}
+
+// Prev returns the KSUID that immediately precedes id; it is the inverse of Next.
+func (id KSUID) Prev() KSUID {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]--
+		if id[i] != 0xff {
+			break
+		}
+	}
+	return id
+}
+
+// MarshalText implements encoding.TextMarshaler using the base62 form.
+func (id KSUID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and accepts the form of ParseKSUID.
+func (id *KSUID) UnmarshalText(text []byte) error {
+	parsed, err := ParseKSUID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
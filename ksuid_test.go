@@ -0,0 +1,158 @@
+package random_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestNewKSUID(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now().Truncate(time.Second)
+	id := random.NewKSUID()
+	after := time.Now()
+
+	require.Regexp(t, `^[0-9A-Za-z]{27}$`, id.String())
+	assert.False(t, id.Time().Before(before))
+	assert.False(t, id.Time().After(after))
+	assert.Len(t, id.Payload(), 16)
+
+	t.Run("seeded generator", func(t *testing.T) {
+		t.Parallel()
+
+		ts := time.Unix(random.KSUIDEpoch+107608047, 0)
+		a := random.NewSeeded(9).NewKSUID(ts)
+		b := random.NewSeeded(9).NewKSUID(ts)
+		assert.Equal(t, a, b)
+		assert.Equal(t, uint32(107608047), a.Timestamp())
+	})
+
+	t.Run("clamps out of range times", func(t *testing.T) {
+		t.Parallel()
+
+		g := random.NewSeeded(1)
+		assert.Equal(t, uint32(0), g.NewKSUID(time.Unix(0, 0)).Timestamp())
+		assert.Equal(t, uint32(1<<32-1), g.NewKSUID(time.Unix(random.KSUIDEpoch+1<<33, 0)).Timestamp())
+	})
+}
+
+func TestParseKSUID(t *testing.T) {
+	t.Parallel()
+
+	const s = "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+	payload, err := hex.DecodeString("b5a1cd34b5f99d1154fb6853345c9735")
+	require.NoError(t, err)
+
+	id, err := random.ParseKSUID(s)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(107608047), id.Timestamp())
+	assert.Equal(t, time.Unix(1507608047, 0), id.Time())
+	assert.Equal(t, payload, id.Payload())
+	assert.Equal(t, s, id.String())
+
+	assert.Equal(t, "000000000000000000000000000", random.NilKSUID.String())
+	maxID, err := random.ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V")
+	require.NoError(t, err)
+	assert.Equal(t, "aWgEPTl1tmebfsQzFP4bxwgy80V", maxID.String())
+	assert.Equal(t, random.NilKSUID, maxID.Next())
+
+	for _, invalid := range []string{
+		"",
+		s[:26],
+		s + "0",
+		"aWgEPTl1tmebfsQzFP4bxwgy80W",
+		"zzzzzzzzzzzzzzzzzzzzzzzzzzz",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO-",
+	} {
+		_, err := random.ParseKSUID(invalid)
+		require.ErrorIs(t, err, random.ErrInvalidKSUID, invalid)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		for range 100 {
+			id := random.NewKSUID()
+			parsed, err := random.ParseKSUID(id.String())
+			require.NoError(t, err)
+			assert.Equal(t, id, parsed)
+
+			fromBytes, err := random.KSUIDFromBytes(id.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, id, fromBytes)
+		}
+
+		_, err := random.KSUIDFromBytes(make([]byte, 16))
+		require.ErrorIs(t, err, random.ErrInvalidKSUID)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(map[string]random.KSUID{"id": id})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":"`+s+`"}`, string(data))
+
+		var got map[string]random.KSUID
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, id, got["id"])
+	})
+}
+
+func TestKSUID_NextPrev(t *testing.T) {
+	t.Parallel()
+
+	id := random.NewKSUID()
+	next := id.Next()
+	assert.Equal(t, 1, next.Compare(id))
+	assert.Equal(t, id.Timestamp(), next.Timestamp())
+	assert.Equal(t, id, next.Prev())
+	assert.Equal(t, -1, id.Prev().Compare(id))
+
+	t.Run("carries into the timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		var b [20]byte
+		b[3] = 5
+		for i := 4; i < len(b); i++ {
+			b[i] = 0xff
+		}
+		id, err := random.KSUIDFromBytes(b[:])
+		require.NoError(t, err)
+
+		next := id.Next()
+		assert.Equal(t, uint32(6), next.Timestamp())
+		assert.Equal(t, make([]byte, 16), next.Payload())
+		assert.Equal(t, id, next.Prev())
+	})
+
+	t.Run("sequence sorts", func(t *testing.T) {
+		t.Parallel()
+
+		ids := []random.KSUID{random.NewKSUID()}
+		for range 99 {
+			ids = append(ids, ids[len(ids)-1].Next())
+		}
+		shuffled := slices.Clone(ids)
+		random.New(nil).Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+
+		random.SortKSUIDs(shuffled)
+		assert.Equal(t, ids, shuffled)
+
+		strs := make([]string, len(ids))
+		for i, id := range ids {
+			strs[i] = id.String()
+		}
+		assert.True(t, slices.IsSorted(strs))
+	})
+}
@@ -0,0 +1,69 @@
+package random
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+const (
+	// NanoIDAlphabet is NanoID's default URL-safe alphabet of 64 characters, in the same
+	// order as the JavaScript implementation.
+	NanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+	// defaultNanoIDSize gives about 126 bits of entropy with the default alphabet.
+	defaultNanoIDSize = 21
+)
+
+// NanoID generates a NanoID-compatible ID of size characters using crypto/rand.
+// The default size is 21 and the default alphabet is NanoIDAlphabet; the package charset
+// constants can be passed instead and are combined and deduplicated as in String.
+// Characters are selected with NanoID's mask-based algorithm, so every character of the
+// alphabet is equally likely.
+// Returns ErrInvalidCharset if the alphabet is not ASCII or has fewer than 2 distinct characters.
+//
+// Example:
+//
+//	id, err := random.NanoID(0)                                     // "V1StGXR8_Z5jdHi6B-myT"
+//	id, err := random.NanoID(10, random.Numeric, random.Lowercase) // "4f90d13a42"
+func NanoID(size int, alphabet ...string) (string, error) {
+	return secureGenerator.NanoID(size, alphabet...)
+}
+
+// NanoID generates a NanoID-compatible ID, drawing random bytes from the Generator's Source.
+// See the package-level NanoID for details.
+func (g *Generator) NanoID(size int, alphabet ...string) (string, error) {
+	if size <= 0 {
+		size = defaultNanoIDSize
+	}
+	chars := NanoIDAlphabet
+	if len(alphabet) > 0 {
+		chars = joinCharsets(alphabet)
+	}
+	if len(chars) < 2 {
+		return "", fmt.Errorf("%w: NanoID alphabet needs at least 2 characters", ErrInvalidCharset)
+	}
+	for i := 0; i < len(chars); i++ {
+		if chars[i] >= 0x80 {
+			return "", fmt.Errorf("%w: NanoID alphabet must be ASCII", ErrInvalidCharset)
+		}
+	}
+
+	// Random bytes are masked to the smallest power of two covering the alphabet, and values
+	// outside the alphabet are discarded. step over-allocates by 1.6x to make a second
+	// round of random bytes rarely necessary.
+	mask := 1<<bits.Len(uint(len(chars)-1)) - 1
+	step := (16*mask*size + 10*len(chars) - 1) / (10 * len(chars))
+	buf := make([]byte, step)
+
+	id := make([]byte, 0, size)
+	for {
+		g.Read(buf)
+		for _, b := range buf {
+			if idx := int(b) & mask; idx < len(chars) {
+				id = append(id, chars[idx])
+				if len(id) == size {
+					return string(id), nil
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package random_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestNanoID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Len(t, random.NanoIDAlphabet, 64)
+
+		seen := make(map[string]bool)
+		for range 1000 {
+			id, err := random.NanoID(0)
+			require.NoError(t, err)
+			require.Regexp(t, `^[A-Za-z0-9_-]{21}$`, id)
+			require.False(t, seen[id], "duplicate ID %q", id)
+			seen[id] = true
+		}
+	})
+
+	t.Run("custom size and alphabet", func(t *testing.T) {
+		t.Parallel()
+
+		id, err := random.NanoID(10, random.Numeric, random.Lowercase)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[0-9a-z]{10}$`, id)
+
+		id, err = random.NanoID(200, "ab")
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^[ab]{200}$`), id)
+	})
+
+	t.Run("uniform distribution", func(t *testing.T) {
+		t.Parallel()
+
+		// 36 characters are masked to 6 bits, so 28 of every 64 byte values are rejected
+		id, err := random.NanoID(36000, random.Uppercase, random.Numeric)
+		require.NoError(t, err)
+
+		for _, c := range random.Uppercase + random.Numeric {
+			n := strings.Count(id, string(c))
+			assert.InDelta(t, 1000, n, 150, "character %q appeared %d times", c, n)
+		}
+	})
+
+	t.Run("invalid alphabet", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.NanoID(10, "a")
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+		_, err = random.NanoID(10, "aaaa")
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+		_, err = random.NanoID(10, "абв")
+		require.ErrorIs(t, err, random.ErrInvalidCharset)
+	})
+
+	t.Run("seeded generator", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := random.NewSeeded(5).NanoID(21)
+		require.NoError(t, err)
+		b, err := random.NewSeeded(5).NanoID(21)
+		require.NoError(t, err)
+		assert.Equal(t, a, b)
+	})
+}
@@ -1,14 +1,14 @@
 package random
 
-import (
-	"crypto/rand"
-	"math/big"
-)
+import "math"
+
+// maxOTPLength is the number of digits OTP lengths are clamped to.
+const maxOTPLength = 64
 
 // OTP generates a cryptographically secure one-time password (OTP).
 // The default length is 6 digits if no length is specified.
 // Only numeric characters (0-9) are used.
-// Returns an error if the cryptographic random number generator fails.
+// The error result is kept for API stability: crypto/rand never fails since Go 1.24.
 //
 // Length handling:
 //   - No length or length <= 0: defaults to 6 digits
@@ -25,6 +25,13 @@ import (
 //	    return err
 //	}
 func OTP(length ...int) (string, error) {
+	return secureGenerator.OTP(length...), nil
+}
+
+// OTP generates a numeric one-time password using the Generator's Source.
+// Length handling is the same as for the package-level OTP function.
+// The result is cryptographically secure only if the Generator's Source is.
+func (g *Generator) OTP(length ...int) string {
 	// Default to 6 digits
 	otpLength := 6
 	if len(length) > 0 && length[0] > 0 {
@@ -32,22 +39,36 @@ func OTP(length ...int) (string, error) {
 	}
 
 	// Prevent excessive memory allocation (max 64 digits)
-	const maxOTPLength = 64
 	if otpLength > maxOTPLength {
 		otpLength = maxOTPLength
 	}
 
-	const digits = "0123456789"
-	maxIndex := big.NewInt(int64(len(digits)))
-
 	result := make([]byte, otpLength)
-	for i := 0; i < otpLength; i++ {
-		n, err := rand.Int(rand.Reader, maxIndex)
-		if err != nil {
-			return "", err
-		}
-		result[i] = digits[n.Int64()]
+	for i := range result {
+		result[i] = Numeric[g.IntN(len(Numeric))]
 	}
 
-	return string(result), nil
+	return string(result)
+}
+
+// OTPForEntropy generates a cryptographically secure numeric one-time password with at
+// least the given bits of entropy instead of a fixed number of digits. A non-positive
+// target yields the default 6 digits.
+// Returns ErrEntropyTooHigh if the target needs more than 64 digits.
+//
+// Example:
+//
+//	otp, err := random.OTPForEntropy(20) // 7 digits, ~23.3 bits
+func OTPForEntropy(bits float64) (string, error) {
+	return secureGenerator.OTPForEntropy(bits)
+}
+
+// OTPForEntropy generates a numeric one-time password with at least the given bits of
+// entropy using the Generator's Source. See the package-level OTPForEntropy for details.
+func (g *Generator) OTPForEntropy(bits float64) (string, error) {
+	length, err := lengthForEntropy(bits, math.Log2(float64(len(Numeric))), maxOTPLength)
+	if err != nil {
+		return "", err
+	}
+	return g.OTP(length), nil
 }
@@ -1,6 +1,7 @@
 package random_test
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 
@@ -99,3 +100,23 @@ func TestOTP_NegativeLength(t *testing.T) {
 		t.Errorf("OTP(-5) length = %v, want 6", len(otp))
 	}
 }
+
+func TestOTPForEntropy(t *testing.T) {
+	t.Parallel()
+
+	numericRegex := regexp.MustCompile(`^[0-9]+$`)
+	for bits, want := range map[float64]int{0: 6, 19.9: 6, 20: 7, 128: 39} {
+		otp, err := random.OTPForEntropy(bits)
+		if err != nil {
+			t.Fatalf("OTPForEntropy(%v) unexpected error: %v", bits, err)
+		}
+		if len(otp) != want || !numericRegex.MatchString(otp) {
+			t.Errorf("OTPForEntropy(%v) = %q, want %d digits", bits, otp, want)
+		}
+	}
+
+	// 64 digits carry ~212.6 bits
+	if _, err := random.OTPForEntropy(213); !errors.Is(err, random.ErrEntropyTooHigh) {
+		t.Errorf("OTPForEntropy(213) error = %v, want ErrEntropyTooHigh", err)
+	}
+}
@@ -0,0 +1,198 @@
+package random
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidWordlist is returned when a wordlist has fewer than two distinct words.
+var ErrInvalidWordlist = errors.New("random: invalid wordlist")
+
+// defaultPassphraseWords is used when the requested word count is not positive.
+// Six words from the EFF large wordlist carry about 77 bits of entropy.
+const defaultPassphraseWords = 6
+
+// defaultPassphraseSeparator is used when PassphraseOptions.Separator is empty. No word of
+// the bundled wordlists contains a space, unlike "-", which appears in "t-shirt" and "yo-yo".
+const defaultPassphraseSeparator = " "
+
+var (
+	//go:embed wordlists/eff_large_wordlist.txt
+	effLargeWordlistData string
+	//go:embed wordlists/eff_short_wordlist_2_0.txt
+	effShortWordlistData string
+
+	effLargeWordlist = sync.OnceValue(func() Wordlist { return mustParseWordlist(effLargeWordlistData) })
+	effShortWordlist = sync.OnceValue(func() Wordlist { return mustParseWordlist(effShortWordlistData) })
+)
+
+// Wordlist is a list of distinct words used to build passphrases.
+type Wordlist []string
+
+// EFFLargeWordlist returns the EFF large wordlist (7776 words, about 12.9 bits per word),
+// designed for rolling five dice. The wordlist is published by the Electronic Frontier
+// Foundation under the CC BY 3.0 US license.
+func EFFLargeWordlist() Wordlist {
+	return slices.Clone(effLargeWordlist())
+}
+
+// EFFShortWordlist returns the EFF short wordlist 2.0 (1296 words, about 10.3 bits per word),
+// whose words have unique three-character prefixes and are easy to type.
+// The wordlist is published by the Electronic Frontier Foundation under the CC BY 3.0 US license.
+func EFFShortWordlist() Wordlist {
+	return slices.Clone(effShortWordlist())
+}
+
+// ParseWordlist reads a wordlist with one word per line. Lines in diceware format, where the
+// word is preceded by its dice roll ("11111\tabacus"), are supported. Blank lines and
+// duplicate words are skipped. Returns ErrInvalidWordlist if fewer than two distinct
+// words remain.
+//
+// Example:
+//
+//	f, err := os.Open("wordlist.txt")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	words, err := random.ParseWordlist(f)
+func ParseWordlist(r io.Reader) (Wordlist, error) {
+	var words Wordlist
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		word := fields[len(fields)-1]
+		if len(fields) > 2 || (len(fields) == 2 && strings.Trim(fields[0], "0123456789") != "") {
+			return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidWordlist, scanner.Text())
+		}
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(words) < 2 {
+		return nil, fmt.Errorf("%w: need at least 2 distinct words, got %d", ErrInvalidWordlist, len(words))
+	}
+	return words, nil
+}
+
+// mustParseWordlist parses an embedded wordlist and panics if it is malformed.
+func mustParseWordlist(data string) Wordlist {
+	words, err := ParseWordlist(strings.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return words
+}
+
+// PassphraseOptions configures Passphrase.
+type PassphraseOptions struct {
+	// Wordlist to draw words from. Defaults to the EFF large wordlist.
+	Wordlist Wordlist
+	// Separator is placed between words. Defaults to a space. Words containing the
+	// separator are left out of the wordlist so word boundaries stay unambiguous.
+	Separator string
+	// NoSeparator joins the words without a separator, e.g. "CorrectHorseBattery" when
+	// combined with Capitalize. Separator is ignored when it is set.
+	NoSeparator bool
+	// Capitalize upper-cases the first letter of every word.
+	Capitalize bool
+	// AppendDigit appends a random Numeric character to the passphrase.
+	AppendDigit bool
+	// AppendSymbol appends a random Symbols character to the passphrase.
+	AppendSymbol bool
+}
+
+// Passphrase generates a cryptographically secure diceware-style passphrase of the given
+// number of words (default 6), selected uniformly from the wordlist with crypto/rand.
+// Duplicate words in a custom wordlist are counted once, and words containing the separator
+// are skipped. Returns ErrInvalidWordlist if fewer than two distinct usable words remain.
+//
+// Example:
+//
+//	phrase, err := random.Passphrase(6)  // "cradle unshaved prism stoneware overrun sprang"
+//
+//	phrase, err := random.Passphrase(4, random.PassphraseOptions{
+//	    Wordlist:    random.EFFShortWordlist(),
+//	    NoSeparator: true,
+//	    Capitalize:  true,
+//	    AppendDigit: true,
+//	}) // "AcornPaganRuckusYodel7"
+func Passphrase(words int, opts ...PassphraseOptions) (string, error) {
+	return secureGenerator.Passphrase(words, opts...)
+}
+
+// Passphrase generates a passphrase of the given number of words, drawing randomness
+// from the Generator's Source. See the package-level Passphrase for details.
+func (g *Generator) Passphrase(words int, opts ...PassphraseOptions) (string, error) {
+	var opt PassphraseOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if words <= 0 {
+		words = defaultPassphraseWords
+	}
+
+	separator := opt.Separator
+	switch {
+	case opt.NoSeparator:
+		separator = ""
+	case separator == "":
+		separator = defaultPassphraseSeparator
+	}
+
+	wordlist := effLargeWordlist()
+	if opt.Wordlist != nil {
+		// Duplicates would make some words more likely than others
+		wordlist = slices.Compact(slices.Sorted(slices.Values(opt.Wordlist)))
+	}
+	if separator != "" && slices.ContainsFunc(wordlist, func(word string) bool { return strings.Contains(word, separator) }) {
+		// A separator inside a word, as in "yo-yo", would make the phrase read as more words
+		wordlist = slices.DeleteFunc(slices.Clone(wordlist), func(word string) bool { return strings.Contains(word, separator) })
+	}
+	if len(wordlist) < 2 {
+		return "", fmt.Errorf("%w: need at least 2 distinct words, got %d", ErrInvalidWordlist, len(wordlist))
+	}
+
+	selected := make([]string, words)
+	for i := range selected {
+		word := wordlist[g.IntN(len(wordlist))]
+		if opt.Capitalize {
+			word = capitalize(word)
+		}
+		selected[i] = word
+	}
+
+	phrase := strings.Join(selected, separator)
+	if opt.AppendDigit {
+		phrase += string(Numeric[g.IntN(len(Numeric))])
+	}
+	if opt.AppendSymbol {
+		phrase += string(Symbols[g.IntN(len(Symbols))])
+	}
+	return phrase, nil
+}
+
+// capitalize upper-cases the first rune of word.
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if r == utf8.RuneError {
+		return word
+	}
+	return string(unicode.ToUpper(r)) + word[size:]
+}
@@ -0,0 +1,163 @@
+package random_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestEFFWordlists(t *testing.T) {
+	t.Parallel()
+
+	large := random.EFFLargeWordlist()
+	require.Len(t, large, 7776)
+	assert.Equal(t, "abacus", large[0])
+	assert.Equal(t, "zoom", large[len(large)-1])
+
+	short := random.EFFShortWordlist()
+	require.Len(t, short, 1296)
+	assert.Equal(t, "aardvark", short[0])
+	assert.Equal(t, "zucchini", short[len(short)-1])
+
+	// Callers get their own copy
+	large[0] = "changed"
+	assert.Equal(t, "abacus", random.EFFLargeWordlist()[0])
+}
+
+func TestParseWordlist(t *testing.T) {
+	t.Parallel()
+
+	t.Run("plain and diceware formats", func(t *testing.T) {
+		t.Parallel()
+
+		words, err := random.ParseWordlist(strings.NewReader("alpha\n\n11112\tbravo\n  charlie  \nalpha\n"))
+		require.NoError(t, err)
+		assert.Equal(t, random.Wordlist{"alpha", "bravo", "charlie"}, words)
+	})
+
+	t.Run("too few words", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.ParseWordlist(strings.NewReader("alpha\nalpha\n"))
+		require.ErrorIs(t, err, random.ErrInvalidWordlist)
+	})
+
+	t.Run("malformed line", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.ParseWordlist(strings.NewReader("alpha\ntwo words here\n"))
+		require.ErrorIs(t, err, random.ErrInvalidWordlist)
+	})
+}
+
+func TestPassphrase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		phrase, err := random.Passphrase(0)
+		require.NoError(t, err)
+
+		// No bundled word contains a space, so the default separator splits unambiguously
+		words := strings.Split(phrase, " ")
+		require.Len(t, words, 6)
+		large := random.EFFLargeWordlist()
+		for _, word := range words {
+			assert.Contains(t, large, word)
+		}
+	})
+
+	t.Run("separator occurring inside words", func(t *testing.T) {
+		t.Parallel()
+
+		// The large list contains "drop-down", "felt-tip", "t-shirt" and "yo-yo"
+		g := random.NewSeeded(1)
+		for range 2000 {
+			phrase, err := g.Passphrase(6, random.PassphraseOptions{Separator: "-"})
+			require.NoError(t, err)
+			require.Len(t, strings.Split(phrase, "-"), 6, phrase)
+		}
+
+		_, err := random.Passphrase(3, random.PassphraseOptions{
+			Wordlist:  random.Wordlist{"yo-yo", "t-shirt", "alpha"},
+			Separator: "-",
+		})
+		require.ErrorIs(t, err, random.ErrInvalidWordlist)
+
+		phrase, err := random.Passphrase(3, random.PassphraseOptions{
+			Wordlist:  random.Wordlist{"yo-yo", "beta", "alpha"},
+			Separator: "-",
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, phrase, "yo")
+	})
+
+	t.Run("no separator", func(t *testing.T) {
+		t.Parallel()
+
+		phrase, err := random.Passphrase(3, random.PassphraseOptions{
+			Wordlist:    random.Wordlist{"correct", "horse", "battery"},
+			Separator:   "-",
+			NoSeparator: true,
+			Capitalize:  true,
+		})
+		require.NoError(t, err)
+		assert.Regexp(t, `^(Correct|Horse|Battery){3}$`, phrase)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		phrase, err := random.Passphrase(4, random.PassphraseOptions{
+			Wordlist:     random.EFFShortWordlist(),
+			Separator:    " ",
+			Capitalize:   true,
+			AppendDigit:  true,
+			AppendSymbol: true,
+		})
+		require.NoError(t, err)
+
+		words := strings.Split(phrase, " ")
+		require.Len(t, words, 4)
+		for _, word := range words {
+			assert.Regexp(t, `^[A-Z]`, word)
+		}
+
+		last := phrase[len(phrase)-2:]
+		assert.True(t, strings.ContainsRune(random.Numeric, rune(last[0])), phrase)
+		assert.True(t, strings.ContainsRune(random.Symbols, rune(last[1])), phrase)
+	})
+
+	t.Run("custom wordlist", func(t *testing.T) {
+		t.Parallel()
+
+		phrase, err := random.Passphrase(10, random.PassphraseOptions{Wordlist: random.Wordlist{"red", "green", "blue", "red"}})
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^(red|green|blue)( (red|green|blue)){9}$`), phrase)
+	})
+
+	t.Run("invalid custom wordlist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.Passphrase(3, random.PassphraseOptions{Wordlist: random.Wordlist{"same", "same"}})
+		require.ErrorIs(t, err, random.ErrInvalidWordlist)
+	})
+
+	t.Run("randomness check", func(t *testing.T) {
+		t.Parallel()
+
+		results := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			phrase, err := random.Passphrase(4)
+			require.NoError(t, err)
+			results[phrase] = true
+		}
+		require.Len(t, results, 100)
+	})
+}
@@ -0,0 +1,159 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsatisfiablePolicy is returned when no password can satisfy a PasswordPolicy.
+var ErrUnsatisfiablePolicy = errors.New("random: password policy cannot be satisfied")
+
+// defaultPasswordLength is used when PasswordPolicy.Length is not set.
+const defaultPasswordLength = 16
+
+// maxPasswordAttempts bounds the retries when NoRepeat or NoSequence paint
+// the generation into a corner.
+const maxPasswordAttempts = 100
+
+// PasswordPolicy describes the composition rules for Password.
+// Characters are drawn from Uppercase, Lowercase, Numeric and Symbols; use Exclude
+// to remove characters or whole classes (for example Exclude: random.Symbols).
+type PasswordPolicy struct {
+	// Length is the total password length. Defaults to 16 if <= 0.
+	Length int
+	// MinUpper is the minimum number of Uppercase characters.
+	MinUpper int
+	// MinLower is the minimum number of Lowercase characters.
+	MinLower int
+	// MinDigits is the minimum number of Numeric characters.
+	MinDigits int
+	// MinSymbols is the minimum number of Symbols characters.
+	MinSymbols int
+	// Exclude lists characters that must never appear in the password.
+	Exclude string
+	// NoRepeat forbids the same character twice in a row, as in "aa".
+	NoRepeat bool
+	// NoSequence forbids three consecutive ascending or descending characters,
+	// as in "abc" or "321".
+	NoSequence bool
+}
+
+// Password generates a cryptographically secure password that satisfies the policy.
+// Returns ErrUnsatisfiablePolicy if the policy is contradictory, for example when
+// the minimum counts exceed the length or a required class is fully excluded.
+//
+// Example:
+//
+//	password, err := random.Password(random.PasswordPolicy{
+//	    Length:     16,
+//	    MinUpper:   1,
+//	    MinLower:   1,
+//	    MinDigits:  2,
+//	    MinSymbols: 1,
+//	    Exclude:    random.Ambiguous,
+//	    NoRepeat:   true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+func Password(policy PasswordPolicy) (string, error) {
+	return secureGenerator.Password(policy)
+}
+
+// Password generates a password that satisfies the policy, drawing randomness from
+// the Generator's Source. See the package-level Password for details.
+func (g *Generator) Password(policy PasswordPolicy) (string, error) {
+	length := policy.Length
+	if length <= 0 {
+		length = defaultPasswordLength
+	}
+
+	exclude := Charset(policy.Exclude)
+	classes := []struct {
+		name    string
+		charset Charset
+		min     int
+	}{
+		{"uppercase", Charset(Uppercase).Minus(exclude), policy.MinUpper},
+		{"lowercase", Charset(Lowercase).Minus(exclude), policy.MinLower},
+		{"digit", Charset(Numeric).Minus(exclude), policy.MinDigits},
+		{"symbol", Charset(Symbols).Minus(exclude), policy.MinSymbols},
+	}
+
+	// Slots hold the charset each position is drawn from; required classes first,
+	// the remaining positions draw from every allowed character.
+	var pool Charset
+	slots := make([]Charset, 0, length)
+	for _, class := range classes {
+		if class.min < 0 {
+			return "", fmt.Errorf("%w: negative minimum %s count", ErrUnsatisfiablePolicy, class.name)
+		}
+		if class.min > 0 && class.charset == "" {
+			return "", fmt.Errorf("%w: all %s characters are excluded", ErrUnsatisfiablePolicy, class.name)
+		}
+		for range class.min {
+			slots = append(slots, class.charset)
+		}
+		pool += class.charset
+	}
+	if len(slots) > length {
+		return "", fmt.Errorf("%w: minimum counts exceed length %d", ErrUnsatisfiablePolicy, length)
+	}
+	if pool == "" {
+		return "", fmt.Errorf("%w: all characters are excluded", ErrUnsatisfiablePolicy)
+	}
+	if policy.NoRepeat && length > 1 && len(pool) == 1 {
+		return "", fmt.Errorf("%w: a single character cannot avoid repeats", ErrUnsatisfiablePolicy)
+	}
+	for len(slots) < length {
+		slots = append(slots, pool)
+	}
+
+	for range maxPasswordAttempts {
+		if password, ok := g.fillPassword(slots, policy); ok {
+			return password, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no password found after %d attempts", ErrUnsatisfiablePolicy, maxPasswordAttempts)
+}
+
+// fillPassword shuffles the slots and picks a character for each of them, skipping
+// characters that would break the NoRepeat or NoSequence rules.
+// Reports false if some position has no valid character left.
+func (g *Generator) fillPassword(slots []Charset, policy PasswordPolicy) (string, bool) {
+	order := make([]Charset, len(slots))
+	copy(order, slots)
+	g.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+	password := make([]byte, 0, len(order))
+	candidates := make([]byte, 0, len(Alphanumeric)+len(Symbols))
+	for _, charset := range order {
+		candidates = candidates[:0]
+		for i := 0; i < len(charset); i++ {
+			if c := charset[i]; passwordCharAllowed(password, c, policy) {
+				candidates = append(candidates, c)
+			}
+		}
+		if len(candidates) == 0 {
+			return "", false
+		}
+		password = append(password, candidates[g.IntN(len(candidates))])
+	}
+	return string(password), true
+}
+
+// passwordCharAllowed reports whether c may follow password under the policy.
+func passwordCharAllowed(password []byte, c byte, policy PasswordPolicy) bool {
+	n := len(password)
+	if policy.NoRepeat && n > 0 && password[n-1] == c {
+		return false
+	}
+	if policy.NoSequence && n > 1 {
+		a, b := int(password[n-2]), int(password[n-1])
+		step := b - a
+		if (step == 1 || step == -1) && int(c)-b == step {
+			return false
+		}
+	}
+	return true
+}
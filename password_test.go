@@ -0,0 +1,133 @@
+package random_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func countIn(s, charset string) int {
+	n := 0
+	for _, char := range s {
+		if strings.ContainsRune(charset, char) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPassword(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default length", func(t *testing.T) {
+		t.Parallel()
+
+		password, err := random.Password(random.PasswordPolicy{})
+		require.NoError(t, err)
+		require.Len(t, password, 16)
+	})
+
+	t.Run("minimum counts per class", func(t *testing.T) {
+		t.Parallel()
+
+		policy := random.PasswordPolicy{
+			Length:     12,
+			MinUpper:   2,
+			MinLower:   3,
+			MinDigits:  4,
+			MinSymbols: 1,
+		}
+
+		for i := 0; i < 200; i++ {
+			password, err := random.Password(policy)
+			require.NoError(t, err)
+			require.Len(t, password, 12)
+			require.GreaterOrEqual(t, countIn(password, random.Uppercase), 2)
+			require.GreaterOrEqual(t, countIn(password, random.Lowercase), 3)
+			require.GreaterOrEqual(t, countIn(password, random.Numeric), 4)
+			require.GreaterOrEqual(t, countIn(password, random.Symbols), 1)
+		}
+	})
+
+	t.Run("exact composition", func(t *testing.T) {
+		t.Parallel()
+
+		password, err := random.Password(random.PasswordPolicy{Length: 4, MinUpper: 1, MinLower: 1, MinDigits: 1, MinSymbols: 1})
+		require.NoError(t, err)
+		require.Equal(t, 1, countIn(password, random.Uppercase))
+		require.Equal(t, 1, countIn(password, random.Lowercase))
+		require.Equal(t, 1, countIn(password, random.Numeric))
+		require.Equal(t, 1, countIn(password, random.Symbols))
+	})
+
+	t.Run("excluded characters", func(t *testing.T) {
+		t.Parallel()
+
+		policy := random.PasswordPolicy{Length: 64, MinDigits: 2, Exclude: random.Symbols + random.Ambiguous}
+		for i := 0; i < 50; i++ {
+			password, err := random.Password(policy)
+			require.NoError(t, err)
+			require.False(t, strings.ContainsAny(password, random.Symbols+random.Ambiguous))
+		}
+	})
+
+	t.Run("no repeat", func(t *testing.T) {
+		t.Parallel()
+
+		policy := random.PasswordPolicy{Length: 64, Exclude: random.Alphabetic + random.Symbols, NoRepeat: true}
+		for i := 0; i < 50; i++ {
+			password, err := random.Password(policy)
+			require.NoError(t, err)
+			for j := 1; j < len(password); j++ {
+				require.NotEqual(t, password[j-1], password[j], password)
+			}
+		}
+	})
+
+	t.Run("no sequence", func(t *testing.T) {
+		t.Parallel()
+
+		policy := random.PasswordPolicy{Length: 64, Exclude: random.Alphabetic + random.Symbols + "56789", NoSequence: true}
+		for i := 0; i < 50; i++ {
+			password, err := random.Password(policy)
+			require.NoError(t, err)
+			for j := 2; j < len(password); j++ {
+				step := int(password[j-1]) - int(password[j-2])
+				if step == 1 || step == -1 {
+					require.NotEqual(t, step, int(password[j])-int(password[j-1]), password)
+				}
+			}
+		}
+	})
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		policy := random.PasswordPolicy{MinUpper: 1, MinDigits: 1, NoRepeat: true}
+		a, err := random.NewSeeded(3).Password(policy)
+		require.NoError(t, err)
+		b, err := random.NewSeeded(3).Password(policy)
+		require.NoError(t, err)
+		require.Equal(t, a, b)
+	})
+
+	t.Run("unsatisfiable policies", func(t *testing.T) {
+		t.Parallel()
+
+		policies := map[string]random.PasswordPolicy{
+			"minimums exceed length":   {Length: 3, MinUpper: 2, MinDigits: 2},
+			"required class excluded":  {MinDigits: 1, Exclude: random.Numeric},
+			"everything excluded":      {Exclude: random.Alphanumeric + random.Symbols},
+			"negative minimum":         {MinSymbols: -1},
+			"single character repeats": {Length: 2, Exclude: random.Alphabetic + random.Symbols + "123456789", NoRepeat: true},
+		}
+
+		for name, policy := range policies {
+			_, err := random.Password(policy)
+			require.ErrorIs(t, err, random.ErrUnsatisfiablePolicy, name)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrInvalidPlaceholder is returned when registering a backslash, an invalid rune or a
+// default placeholder.
+var ErrInvalidPlaceholder = errors.New("random: invalid placeholder")
+
+// defaultPlaceholders are the placeholders every template understands. They cannot be
+// redefined, so a registration elsewhere in the process never changes their meaning.
+var defaultPlaceholders = map[rune][]rune{
+	'A': []rune(Uppercase),
+	'a': []rune(Lowercase),
+	'9': []rune(Numeric),
+	'x': []rune(Hex),
+	'X': []rune(Numeric + "ABCDEF"),
+	'*': []rune(Alphanumeric),
+}
+
+var (
+	placeholdersMu sync.RWMutex
+	// customPlaceholders holds the placeholders added with RegisterPlaceholder.
+	customPlaceholders = map[rune][]rune{}
+)
+
+// Pattern generates a random string from a template, replacing each placeholder with a
+// random character from its charset and copying every other character as is.
+// A backslash escapes the following character so it is copied literally.
+// This function is NOT cryptographically secure.
+//
+// Default placeholders:
+//   - A: Uppercase
+//   - a: Lowercase
+//   - 9: Numeric
+//   - x: Hex (lowercase)
+//   - X: Hex (uppercase)
+//   - *: Alphanumeric
+//
+// Example:
+//
+//	random.Pattern("XXXX-XXXX-9999") // "3F9A-0C1B-4821"
+//	random.Pattern("AA-999-a")       // "KT-305-m"
+//	random.Pattern(`\A-999`)         // "A-730"
+func Pattern(template string) string {
+	return defaultGenerator.Pattern(template)
+}
+
+// Pattern generates a random string from a template, drawing randomness from the
+// Generator's Source. See the package-level Pattern for the template syntax.
+func (g *Generator) Pattern(template string) string {
+	placeholdersMu.RLock()
+	defer placeholdersMu.RUnlock()
+
+	var sb strings.Builder
+	sb.Grow(len(template))
+	escaped := false
+	for _, r := range template {
+		switch {
+		case escaped:
+			escaped = false
+			sb.WriteRune(r)
+		case r == '\\':
+			escaped = true
+		default:
+			charset, ok := defaultPlaceholders[r]
+			if !ok {
+				charset, ok = customPlaceholders[r]
+			}
+			if ok {
+				sb.WriteRune(charset[g.IntN(len(charset))])
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	// A trailing backslash has nothing to escape and is kept literally
+	if escaped {
+		sb.WriteRune('\\')
+	}
+	return sb.String()
+}
+
+// RegisterPlaceholder maps placeholder to the given character sets for Pattern, replacing
+// an earlier registration of the same placeholder. Registrations are process-wide, so pick
+// placeholders unlikely to clash with other packages. It is safe for concurrent use.
+// Returns ErrInvalidPlaceholder if placeholder is a backslash, not a valid rune or one of
+// the default placeholders, and an error if the charsets are empty or not valid UTF-8.
+//
+// Example:
+//
+//	err := random.RegisterPlaceholder('U', random.UnambiguousUpper)
+//	code := random.Pattern("UUUU-UUUU")
+func RegisterPlaceholder(placeholder rune, charsets ...string) error {
+	if placeholder == '\\' || !utf8.ValidRune(placeholder) {
+		return fmt.Errorf("%w: %q", ErrInvalidPlaceholder, placeholder)
+	}
+	if _, ok := defaultPlaceholders[placeholder]; ok {
+		return fmt.Errorf("%w: %q is a default placeholder", ErrInvalidPlaceholder, placeholder)
+	}
+	if err := Charset(strings.Join(charsets, "")).Validate(); err != nil {
+		return err
+	}
+	charset := NewCharset(charsets...)
+
+	placeholdersMu.Lock()
+	defer placeholdersMu.Unlock()
+	customPlaceholders[placeholder] = []rune(charset)
+	return nil
+}
@@ -0,0 +1,91 @@
+package random_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		template string
+		want     *regexp.Regexp
+	}{
+		{"license key", "XXXX-XXXX-9999", regexp.MustCompile(`^[0-9A-F]{4}-[0-9A-F]{4}-[0-9]{4}$`)},
+		{"plate number", "AA-999-a", regexp.MustCompile(`^[A-Z]{2}-[0-9]{3}-[a-z]$`)},
+		{"lowercase hex", "xxxxxxxx", regexp.MustCompile(`^[0-9a-f]{8}$`)},
+		{"alphanumeric", "****", regexp.MustCompile(`^[a-zA-Z0-9]{4}$`)},
+		{"escaped placeholders", `\A\9-99`, regexp.MustCompile(`^A9-[0-9]{2}$`)},
+		{"escaped backslash", `\\9`, regexp.MustCompile(`^\\[0-9]$`)},
+		{"trailing backslash", `99\`, regexp.MustCompile(`^[0-9]{2}\\$`)},
+		{"unicode literals", "№ 999 — ok", regexp.MustCompile(`^№ [0-9]{3} — ok$`)},
+		{"empty template", "", regexp.MustCompile(`^$`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := random.Pattern(tt.template)
+			assert.Regexp(t, tt.want, got)
+		})
+	}
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, random.NewSeeded(5).Pattern("AAAA-9999"), random.NewSeeded(5).Pattern("AAAA-9999"))
+	})
+}
+
+func TestRegisterPlaceholder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("custom placeholder", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, random.RegisterPlaceholder('§', random.UnambiguousUpper))
+
+		got := random.Pattern("§§§§-§§§§")
+		require.Len(t, got, 9)
+		for _, char := range strings.ReplaceAll(got, "-", "") {
+			require.True(t, strings.ContainsRune(random.UnambiguousUpper, char))
+		}
+	})
+
+	t.Run("multibyte charset", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, random.RegisterPlaceholder('¤', "αβγ"))
+
+		got := random.Pattern("¤¤¤")
+		require.Regexp(t, `^[αβγ]{3}$`, got)
+	})
+
+	t.Run("default placeholders cannot be redefined", func(t *testing.T) {
+		t.Parallel()
+
+		for _, placeholder := range "Aa9xX*" {
+			require.ErrorIs(t, random.RegisterPlaceholder(placeholder, "ab"), random.ErrInvalidPlaceholder)
+		}
+		require.Regexp(t, `^[0-9]{4}$`, random.Pattern("9999"))
+	})
+
+	t.Run("invalid registrations", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, random.RegisterPlaceholder('\\', random.Numeric), random.ErrInvalidPlaceholder)
+		require.ErrorIs(t, random.RegisterPlaceholder(utf8.MaxRune+1, random.Numeric), random.ErrInvalidPlaceholder)
+		require.ErrorIs(t, random.RegisterPlaceholder('¶'), random.ErrEmptyCharset)
+		require.ErrorIs(t, random.RegisterPlaceholder('¶', "\xff"), random.ErrInvalidCharset)
+	})
+}
@@ -0,0 +1,177 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// ErrRegexNoMatch is returned when a regular expression cannot match any string.
+var ErrRegexNoMatch = errors.New("random: regular expression matches no string")
+
+// defaultMaxRepeat is the repetition cap for unbounded quantifiers (*, + and {n,}).
+const defaultMaxRepeat = 10
+
+// printableASCII is the preferred universe for character classes and wildcards, so that
+// negated classes and dots produce readable characters rather than arbitrary code points.
+const printableASCII = Alphanumeric + Symbols + "' "
+
+// Regex generates a random string that matches the given Go regular expression
+// (RE2 syntax, as accepted by the regexp package). Unbounded quantifiers (*, + and {n,})
+// repeat at most maxRepeat times beyond their minimum (default 10).
+// Character classes, negated classes and dots draw from printable ASCII when possible and
+// fall back to the full class otherwise. Anchors and word boundaries are not enforced,
+// so patterns should be written so that the generated pieces satisfy them.
+// Returns an error if the pattern cannot be parsed or matches no string.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	s, err := random.Regex(`^[a-z]{3}-\d{4}$`) // "kqz-0192"
+//	s, err := random.Regex(`^\w+@example\.com$`, 5)
+func Regex(pattern string, maxRepeat ...int) (string, error) {
+	return defaultGenerator.Regex(pattern, maxRepeat...)
+}
+
+// Regex generates a random string that matches the given regular expression,
+// drawing randomness from the Generator's Source. See the package-level Regex for details.
+func (g *Generator) Regex(pattern string, maxRepeat ...int) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("random: parse regular expression: %w", err)
+	}
+
+	limit := defaultMaxRepeat
+	if len(maxRepeat) > 0 && maxRepeat[0] >= 0 {
+		limit = maxRepeat[0]
+	}
+
+	var sb strings.Builder
+	if err := g.writeRegex(&sb, re, limit); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// writeRegex appends a random match of re to sb.
+func (g *Generator) writeRegex(sb *strings.Builder, re *syntax.Regexp, maxRepeat int) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return ErrRegexNoMatch
+
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return nil
+
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				r = g.foldCase(r)
+			}
+			sb.WriteRune(r)
+		}
+		return nil
+
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return ErrRegexNoMatch
+		}
+		sb.WriteRune(g.classRune(re.Rune))
+		return nil
+
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		sb.WriteByte(printableASCII[g.IntN(len(printableASCII))])
+		return nil
+
+	case syntax.OpCapture:
+		return g.writeRegex(sb, re.Sub[0], maxRepeat)
+
+	case syntax.OpStar:
+		return g.repeatRegex(sb, re.Sub[0], 0, maxRepeat, maxRepeat)
+
+	case syntax.OpPlus:
+		return g.repeatRegex(sb, re.Sub[0], 1, 1+maxRepeat, maxRepeat)
+
+	case syntax.OpQuest:
+		return g.repeatRegex(sb, re.Sub[0], 0, 1, maxRepeat)
+
+	case syntax.OpRepeat:
+		hi := re.Max
+		if hi < 0 {
+			hi = re.Min + maxRepeat
+		}
+		return g.repeatRegex(sb, re.Sub[0], re.Min, hi, maxRepeat)
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := g.writeRegex(sb, sub, maxRepeat); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case syntax.OpAlternate:
+		return g.writeRegex(sb, re.Sub[g.IntN(len(re.Sub))], maxRepeat)
+	}
+
+	return fmt.Errorf("random: unsupported regular expression operator %v", re.Op)
+}
+
+// repeatRegex appends between lo and hi random matches of re to sb.
+func (g *Generator) repeatRegex(sb *strings.Builder, re *syntax.Regexp, lo, hi, maxRepeat int) error {
+	n := lo + g.IntN(hi-lo+1)
+	for range n {
+		if err := g.writeRegex(sb, re, maxRepeat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// classRune picks a random rune from a character class given as sorted lo-hi range pairs.
+// Printable ASCII members are preferred; otherwise every member is equally likely.
+func (g *Generator) classRune(ranges []rune) rune {
+	inClass := func(r rune) bool {
+		for i := 0; i < len(ranges); i += 2 {
+			if r >= ranges[i] && r <= ranges[i+1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var printable []byte
+	for i := 0; i < len(printableASCII); i++ {
+		if inClass(rune(printableASCII[i])) {
+			printable = append(printable, printableASCII[i])
+		}
+	}
+	if len(printable) > 0 {
+		return rune(printable[g.IntN(len(printable))])
+	}
+
+	var total int
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	n := g.IntN(total)
+	for i := 0; i < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n)
+		}
+		n -= size
+	}
+	return ranges[len(ranges)-1]
+}
+
+// foldCase returns a random member of the case-folding orbit of r.
+func (g *Generator) foldCase(r rune) rune {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	return orbit[g.IntN(len(orbit))]
+}
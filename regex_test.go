@@ -0,0 +1,97 @@
+package random_test
+
+import (
+	"regexp"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestRegex(t *testing.T) {
+	t.Parallel()
+
+	patterns := []string{
+		`^[a-z]{3}-\d{4}$`,
+		`^[A-Z]{2}\d{2,4}$`,
+		`^(foo|bar|baz)_[0-9a-f]+$`,
+		`^\w+@example\.com$`,
+		`^[^a-z]{5}$`,
+		`^.{8}$`,
+		`^(?i)hello$`,
+		`^\s*\d+\s*$`,
+		`^a*b?c+$`,
+		`^(ab){2,}$`,
+		`^[α-ω]{4}$`,
+		`^\bword\b$`,
+		`^$`,
+	}
+
+	for _, pattern := range patterns {
+		t.Run(pattern, func(t *testing.T) {
+			t.Parallel()
+
+			re := regexp.MustCompile(pattern)
+			for i := 0; i < 50; i++ {
+				got, err := random.Regex(pattern)
+				require.NoError(t, err)
+				require.True(t, utf8.ValidString(got))
+				require.Regexp(t, re, got)
+			}
+		})
+	}
+
+	t.Run("negated class uses printable ascii", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := random.Regex(`^[^0-9]{50}$`)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[\x20-\x2f\x3a-\x7e]{50}$`, got)
+	})
+
+	t.Run("repetition cap", func(t *testing.T) {
+		t.Parallel()
+
+		for i := 0; i < 50; i++ {
+			got, err := random.Regex(`^x*$`, 3)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(got), 3)
+
+			got, err = random.Regex(`^y+$`, 0)
+			require.NoError(t, err)
+			require.Equal(t, "y", got)
+
+			got, err = random.Regex(`^z{2,}$`, 2)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, len(got), 2)
+			require.LessOrEqual(t, len(got), 4)
+		}
+	})
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := random.NewSeeded(11).Regex(`^[a-z]+\d*$`)
+		require.NoError(t, err)
+		b, err := random.NewSeeded(11).Regex(`^[a-z]+\d*$`)
+		require.NoError(t, err)
+		require.Equal(t, a, b)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.Regex(`[a-`)
+		require.Error(t, err)
+	})
+
+	t.Run("pattern without matches", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.Regex(`^[^\x00-\x{10FFFF}]$`)
+		require.ErrorIs(t, err, random.ErrRegexNoMatch)
+	})
+}
@@ -0,0 +1,147 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// ErrInvalidShortCode is returned when a short code contains characters outside the
+// alphabet or does not fit in a uint64.
+var ErrInvalidShortCode = errors.New("random: invalid short code")
+
+// maxShortCodeRetries is the number of consecutive collisions Allocate tolerates.
+const maxShortCodeRetries = 10
+
+// ShortCodec converts between uint64 values and short codes using bijective base-N
+// numeration: every string over the alphabet is the code of exactly one number, so there
+// are no leading-zero duplicates and codes are as short as possible.
+// 0 encodes to the first character of the alphabet, 1 to the second, and after the last
+// single-character code come the two-character codes.
+// A ShortCodec is safe for concurrent use.
+type ShortCodec struct {
+	alphabet string
+}
+
+// NewShortCodec returns a codec for the combined character sets, deduplicated as in String.
+// If no character sets are provided, it defaults to Alphanumeric.
+// Returns ErrInvalidCharset if the alphabet is not ASCII or has fewer than 2 distinct characters.
+//
+// Example:
+//
+//	codec, err := random.NewShortCodec()
+//	codec.Encode(0)    // "A"
+//	codec.Encode(61)   // "9"
+//	codec.Encode(62)   // "AA"
+//	codec.Decode("BA") // 124
+func NewShortCodec(alphabet ...string) (*ShortCodec, error) {
+	chars := joinCharsets(alphabet)
+	if len(chars) < 2 {
+		return nil, fmt.Errorf("%w: short code alphabet needs at least 2 characters", ErrInvalidCharset)
+	}
+	for i := 0; i < len(chars); i++ {
+		if chars[i] >= 0x80 {
+			return nil, fmt.Errorf("%w: short code alphabet must be ASCII", ErrInvalidCharset)
+		}
+	}
+	return &ShortCodec{alphabet: chars}, nil
+}
+
+// Encode returns the bijective base-N code of n.
+func (c *ShortCodec) Encode(n uint64) string {
+	base := uint64(len(c.alphabet))
+	var buf [64]byte
+	i := len(buf) - 1
+
+	// Bijective digits are 1..base, so each step takes one off before dividing. The first
+	// step is taken on n itself, which is the code's value minus one, to avoid overflowing n+1.
+	buf[i] = c.alphabet[n%base]
+	for n /= base; n > 0; n /= base {
+		n--
+		i--
+		buf[i] = c.alphabet[n%base]
+	}
+	return string(buf[i:])
+}
+
+// Decode returns the number whose code is code.
+// Returns ErrInvalidShortCode for empty codes, characters outside the alphabet, or codes
+// beyond the uint64 range.
+func (c *ShortCodec) Decode(code string) (uint64, error) {
+	if code == "" {
+		return 0, fmt.Errorf("%w: empty code", ErrInvalidShortCode)
+	}
+	base := uint64(len(c.alphabet))
+
+	var n uint64
+	for i := 0; i < len(code); i++ {
+		d := strings.IndexByte(c.alphabet, code[i])
+		if d < 0 {
+			return 0, fmt.Errorf("%w: illegal character %q", ErrInvalidShortCode, code[i])
+		}
+		if i == 0 {
+			n = uint64(d)
+			continue
+		}
+		// n = (n+1)*base + d, computed as n*base + base + d to stay within uint64
+		hi, lo := bits.Mul64(n, base)
+		var carry1, carry2 uint64
+		lo, carry1 = bits.Add64(lo, base, 0)
+		lo, carry2 = bits.Add64(lo, uint64(d), 0)
+		if hi != 0 || carry1 != 0 || carry2 != 0 {
+			return 0, fmt.Errorf("%w: value overflows uint64", ErrInvalidShortCode)
+		}
+		n = lo
+	}
+	return n, nil
+}
+
+// Keyspace returns the number of distinct codes of the given length, and false if that
+// number does not fit in a uint64.
+func (c *ShortCodec) Keyspace(length int) (uint64, bool) {
+	return keyspaceSize(len(c.alphabet), length)
+}
+
+// Allocate picks a random code of the given length that was not in store before and records
+// it there, so codes cannot be enumerated by counting up. Every code of that length decodes
+// to a uint64. Candidates are drawn with crypto/rand; a nil store skips the collision check.
+// Returns ErrTooManyCollisions after 10 consecutive collisions, which signals that the
+// keyspace of that length is nearly exhausted and a longer length should be used.
+//
+// Example:
+//
+//	store := random.NewMemoryStore()
+//	code, err := codec.Allocate(ctx, 7, store) // "q3ZxT0b"
+//	if errors.Is(err, random.ErrTooManyCollisions) {
+//	    code, err = codec.Allocate(ctx, 8, store)
+//	}
+//	id, _ := codec.Decode(code)
+func (c *ShortCodec) Allocate(ctx context.Context, length int, store Store) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("%w: length must be positive", ErrInvalidShortCode)
+	}
+	// The largest code of a length is the last character repeated
+	if _, err := c.Decode(strings.Repeat(c.alphabet[len(c.alphabet)-1:], length)); err != nil {
+		return "", fmt.Errorf("%w: codes of length %d overflow uint64", ErrInvalidShortCode, length)
+	}
+
+	for range maxShortCodeRetries {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		code := secureGenerator.StringN(length, c.alphabet)
+		if store == nil {
+			return code, nil
+		}
+		added, err := store.Add(ctx, code)
+		if err != nil {
+			return "", fmt.Errorf("random: record short code: %w", err)
+		}
+		if added {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %d consecutive collisions", ErrTooManyCollisions, maxShortCodeRetries)
+}
@@ -0,0 +1,188 @@
+package random_test
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestShortCodec(t *testing.T) {
+	t.Parallel()
+
+	codec, err := random.NewShortCodec()
+	require.NoError(t, err)
+
+	t.Run("known values", func(t *testing.T) {
+		t.Parallel()
+
+		for n, code := range map[uint64]string{
+			0:    "A",
+			25:   "Z",
+			26:   "a",
+			61:   "9",
+			62:   "AA",
+			63:   "AB",
+			124:  "BA",
+			3905: "99",
+			3906: "AAA",
+		} {
+			assert.Equal(t, code, codec.Encode(n), n)
+			decoded, err := codec.Decode(code)
+			require.NoError(t, err)
+			assert.Equal(t, n, decoded, code)
+		}
+	})
+
+	t.Run("bijective", func(t *testing.T) {
+		t.Parallel()
+
+		binary, err := random.NewShortCodec("ab")
+		require.NoError(t, err)
+
+		want := []string{"a", "b", "aa", "ab", "ba", "bb", "aaa", "aab"}
+		for n, code := range want {
+			assert.Equal(t, code, binary.Encode(uint64(n)))
+		}
+
+		seen := make(map[string]bool)
+		for n := range uint64(5000) {
+			code := codec.Encode(n)
+			require.False(t, seen[code], "duplicate code %q", code)
+			seen[code] = true
+
+			decoded, err := codec.Decode(code)
+			require.NoError(t, err)
+			require.Equal(t, n, decoded)
+		}
+	})
+
+	t.Run("uint64 range", func(t *testing.T) {
+		t.Parallel()
+
+		for _, n := range []uint64{math.MaxUint64, math.MaxUint64 - 1, 1 << 63} {
+			decoded, err := codec.Decode(codec.Encode(n))
+			require.NoError(t, err)
+			assert.Equal(t, n, decoded)
+		}
+
+		// The code following MaxUint64 overflows
+		next := []byte(codec.Encode(math.MaxUint64))
+		for i := len(next) - 1; i >= 0; i-- {
+			if next[i] != '9' {
+				next[i] = random.Alphanumeric[strings.IndexByte(random.Alphanumeric, next[i])+1]
+				break
+			}
+			next[i] = 'A'
+		}
+		_, err := codec.Decode(string(next))
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+
+		_, err = codec.Decode(strings.Repeat("9", 20))
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+	})
+
+	t.Run("invalid codes", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := codec.Decode("")
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+
+		_, err = codec.Decode("ab-c")
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+	})
+
+	t.Run("keyspace", func(t *testing.T) {
+		t.Parallel()
+
+		n, ok := codec.Keyspace(3)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(62*62*62), n)
+
+		_, ok = codec.Keyspace(11)
+		assert.False(t, ok)
+	})
+}
+
+func TestNewShortCodec_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := random.NewShortCodec("xx")
+	require.ErrorIs(t, err, random.ErrInvalidCharset)
+
+	_, err = random.NewShortCodec("αβγ")
+	require.ErrorIs(t, err, random.ErrInvalidCharset)
+}
+
+func TestShortCodec_Allocate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("unique codes of the requested length", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := random.NewShortCodec()
+		require.NoError(t, err)
+		store := random.NewMemoryStore()
+
+		for range 500 {
+			code, err := codec.Allocate(ctx, 6, store)
+			require.NoError(t, err)
+			require.Regexp(t, `^[A-Za-z0-9]{6}$`, code)
+
+			_, err = codec.Decode(code)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 500, store.Len())
+	})
+
+	t.Run("exhausted keyspace", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := random.NewShortCodec("ab")
+		require.NoError(t, err)
+		store := random.NewMemoryStore("aa", "ab", "ba", "bb")
+
+		_, err = codec.Allocate(ctx, 2, store)
+		require.ErrorIs(t, err, random.ErrTooManyCollisions)
+
+		code, err := codec.Allocate(ctx, 3, store)
+		require.NoError(t, err)
+		assert.Len(t, code, 3)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := random.NewShortCodec()
+		require.NoError(t, err)
+
+		_, err = codec.Allocate(ctx, 0, nil)
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+
+		_, err = codec.Allocate(ctx, 11, nil)
+		require.ErrorIs(t, err, random.ErrInvalidShortCode)
+
+		code, err := codec.Allocate(ctx, 10, nil)
+		require.NoError(t, err)
+		assert.Len(t, code, 10)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := random.NewShortCodec()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = codec.Allocate(ctx, 6, random.NewMemoryStore())
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
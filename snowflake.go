@@ -0,0 +1,202 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidSnowflakeConfig is returned by NewSnowflake for inconsistent bit layouts or
+	// out-of-range datacenter and worker IDs.
+	ErrInvalidSnowflakeConfig = errors.New("random: invalid snowflake config")
+	// ErrClockMovedBackwards is returned when the clock reads earlier than the time of the
+	// last generated ID. Retry once the clock has caught up.
+	ErrClockMovedBackwards = errors.New("random: clock moved backwards")
+	// ErrSnowflakeTimeRange is returned when the current time is before the epoch or beyond
+	// the range of the timestamp bits.
+	ErrSnowflakeTimeRange = errors.New("random: time outside snowflake range")
+)
+
+// DefaultSnowflakeEpoch is Twitter's Snowflake epoch, 2010-11-04 01:42:54.657 UTC.
+var DefaultSnowflakeEpoch = time.UnixMilli(1288834974657)
+
+const (
+	defaultDatacenterBits = 5
+	defaultWorkerBits     = 5
+	defaultSequenceBits   = 12
+	// maxSnowflakeNodeBits leaves at least 41 timestamp bits (about 69 years) below the sign bit.
+	maxSnowflakeNodeBits = 22
+)
+
+// SnowflakeConfig configures the layout of Snowflake IDs:
+// sign bit (always 0) | timestamp | datacenter ID | worker ID | sequence.
+// The timestamp counts milliseconds since Epoch and gets the bits left over.
+type SnowflakeConfig struct {
+	// Epoch is the start of the timestamp. Defaults to DefaultSnowflakeEpoch.
+	Epoch time.Time
+	// DatacenterBits is the width of the datacenter ID. Defaults to 5; negative disables it.
+	DatacenterBits int
+	// WorkerBits is the width of the worker ID. Defaults to 5; negative disables it.
+	WorkerBits int
+	// SequenceBits is the width of the per-millisecond sequence. Defaults to 12.
+	SequenceBits int
+	// DatacenterID identifies the datacenter, in the range [0, 2^DatacenterBits).
+	DatacenterID int64
+	// WorkerID identifies the worker within its datacenter, in the range [0, 2^WorkerBits).
+	WorkerID int64
+	// Now returns the current time. Defaults to time.Now; override it in tests. When a
+	// sequence is exhausted, Next polls Now until it reaches the next millisecond.
+	Now func() time.Time
+}
+
+// SnowflakeParts are the fields of a decoded Snowflake ID.
+type SnowflakeParts struct {
+	Time         time.Time
+	DatacenterID int64
+	WorkerID     int64
+	Sequence     int64
+}
+
+// Snowflake generates time-ordered int64 IDs that are unique across workers as long as
+// every worker has a distinct datacenter and worker ID. It is safe for concurrent use.
+type Snowflake struct {
+	cfg SnowflakeConfig
+
+	timestampShift  int
+	datacenterShift int
+	workerShift     int
+	timestampMax    int64
+
+	mu       sync.Mutex
+	lastMs   int64
+	sequence int64
+}
+
+// NewSnowflake returns a Snowflake generator for the given configuration.
+// Returns ErrInvalidSnowflakeConfig if the datacenter, worker and sequence bits exceed 22
+// in total or an ID does not fit its bits.
+//
+// Example:
+//
+//	ids, err := random.NewSnowflake(random.SnowflakeConfig{WorkerID: 3})
+//	if err != nil {
+//	    return err
+//	}
+//	id, err := ids.Next()
+func NewSnowflake(cfg SnowflakeConfig) (*Snowflake, error) {
+	cfg = cfg.withDefaults()
+	if cfg.SequenceBits < 1 {
+		return nil, fmt.Errorf("%w: sequence bits must be positive", ErrInvalidSnowflakeConfig)
+	}
+	if n := cfg.DatacenterBits + cfg.WorkerBits + cfg.SequenceBits; n > maxSnowflakeNodeBits {
+		return nil, fmt.Errorf("%w: %d node bits exceed %d", ErrInvalidSnowflakeConfig, n, maxSnowflakeNodeBits)
+	}
+	if cfg.DatacenterID < 0 || cfg.DatacenterID >= 1<<cfg.DatacenterBits {
+		return nil, fmt.Errorf("%w: datacenter ID %d does not fit %d bits", ErrInvalidSnowflakeConfig, cfg.DatacenterID, cfg.DatacenterBits)
+	}
+	if cfg.WorkerID < 0 || cfg.WorkerID >= 1<<cfg.WorkerBits {
+		return nil, fmt.Errorf("%w: worker ID %d does not fit %d bits", ErrInvalidSnowflakeConfig, cfg.WorkerID, cfg.WorkerBits)
+	}
+
+	s := &Snowflake{
+		cfg:             cfg,
+		workerShift:     cfg.SequenceBits,
+		datacenterShift: cfg.SequenceBits + cfg.WorkerBits,
+		timestampShift:  cfg.SequenceBits + cfg.WorkerBits + cfg.DatacenterBits,
+		lastMs:          -1,
+	}
+	s.timestampMax = 1<<(63-s.timestampShift) - 1
+	return s, nil
+}
+
+// Next returns the next ID. IDs from one generator are strictly increasing.
+// When the sequence of the current millisecond is exhausted, Next waits for the next
+// millisecond. Returns ErrClockMovedBackwards if the clock reads earlier than the last
+// generated ID, and ErrSnowflakeTimeRange if the time cannot be represented.
+func (s *Snowflake) Next() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ms, err := s.now()
+	if err != nil {
+		return 0, err
+	}
+	if ms < s.lastMs {
+		return 0, fmt.Errorf("%w: by %s", ErrClockMovedBackwards, time.Duration(s.lastMs-ms)*time.Millisecond)
+	}
+
+	if ms == s.lastMs {
+		s.sequence = (s.sequence + 1) & (1<<s.cfg.SequenceBits - 1)
+		if s.sequence == 0 {
+			// Sequence exhausted: wait for the clock to reach the next millisecond
+			for ms <= s.lastMs {
+				time.Sleep(time.Until(s.cfg.Epoch.Add(time.Duration(s.lastMs+1) * time.Millisecond)))
+				if ms, err = s.now(); err != nil {
+					return 0, err
+				}
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastMs = ms
+
+	return ms<<s.timestampShift |
+		s.cfg.DatacenterID<<s.datacenterShift |
+		s.cfg.WorkerID<<s.workerShift |
+		s.sequence, nil
+}
+
+// Decode splits an ID produced with the same configuration into its fields.
+//
+// Example:
+//
+//	parts := ids.Decode(id)
+//	log.Printf("created %s by worker %d", parts.Time, parts.WorkerID)
+func (s *Snowflake) Decode(id int64) SnowflakeParts {
+	return SnowflakeParts{
+		Time:         s.cfg.Epoch.Add(time.Duration(id>>s.timestampShift) * time.Millisecond),
+		DatacenterID: id >> s.datacenterShift & (1<<s.cfg.DatacenterBits - 1),
+		WorkerID:     id >> s.workerShift & (1<<s.cfg.WorkerBits - 1),
+		Sequence:     id & (1<<s.cfg.SequenceBits - 1),
+	}
+}
+
+// now returns the milliseconds elapsed since the epoch.
+func (s *Snowflake) now() (int64, error) {
+	ms := s.cfg.Now().Sub(s.cfg.Epoch).Milliseconds()
+	if ms < 0 || ms > s.timestampMax {
+		return 0, fmt.Errorf("%w: %d ms since epoch", ErrSnowflakeTimeRange, ms)
+	}
+	return ms, nil
+}
+
+// withDefaults returns a copy of cfg with unset fields replaced by their defaults.
+func (cfg SnowflakeConfig) withDefaults() SnowflakeConfig {
+	if cfg.Epoch.IsZero() {
+		cfg.Epoch = DefaultSnowflakeEpoch
+	}
+	cfg.DatacenterBits = snowflakeBits(cfg.DatacenterBits, defaultDatacenterBits)
+	cfg.WorkerBits = snowflakeBits(cfg.WorkerBits, defaultWorkerBits)
+	if cfg.SequenceBits == 0 {
+		cfg.SequenceBits = defaultSequenceBits
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return cfg
+}
+
+// snowflakeBits applies the default to an unset bit width and maps negative widths to 0.
+func snowflakeBits(bits, def int) int {
+	switch {
+	case bits == 0:
+		return def
+	case bits < 0:
+		return 0
+	default:
+		return bits
+	}
+}
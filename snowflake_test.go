@@ -0,0 +1,206 @@
+package random_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+// fakeClock is a manually advanced clock for Snowflake tests.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+	// step is added to the time after every reading
+	step time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+func (c *fakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+func TestSnowflake(t *testing.T) {
+	t.Parallel()
+
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("layout and decode", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{now: epoch.Add(1500 * time.Millisecond)}
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{
+			Epoch:        epoch,
+			DatacenterID: 3,
+			WorkerID:     17,
+			Now:          clock.Now,
+		})
+		require.NoError(t, err)
+
+		id, err := ids.Next()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500)<<22|3<<17|17<<12, id)
+
+		second, err := ids.Next()
+		require.NoError(t, err)
+		assert.Equal(t, id+1, second)
+
+		parts := ids.Decode(second)
+		assert.True(t, parts.Time.Equal(epoch.Add(1500*time.Millisecond)))
+		assert.Equal(t, int64(3), parts.DatacenterID)
+		assert.Equal(t, int64(17), parts.WorkerID)
+		assert.Equal(t, int64(1), parts.Sequence)
+
+		clock.Set(epoch.Add(1501 * time.Millisecond))
+		third, err := ids.Next()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), ids.Decode(third).Sequence)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{})
+		require.NoError(t, err)
+
+		before := time.Now().Truncate(time.Millisecond)
+		id, err := ids.Next()
+		require.NoError(t, err)
+
+		assert.Positive(t, id)
+		parts := ids.Decode(id)
+		assert.False(t, parts.Time.Before(before))
+		assert.WithinDuration(t, time.Now(), parts.Time, time.Second)
+	})
+
+	t.Run("sequence exhaustion waits for the next millisecond", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{now: epoch, step: time.Millisecond / 4}
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{
+			Epoch:        epoch,
+			SequenceBits: 2,
+			Now:          clock.Now,
+		})
+		require.NoError(t, err)
+
+		var prev int64 = -1
+		for range 100 {
+			id, err := ids.Next()
+			require.NoError(t, err)
+			require.Greater(t, id, prev)
+			prev = id
+		}
+	})
+
+	t.Run("clock moved backwards", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{now: epoch.Add(time.Second)}
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{Epoch: epoch, Now: clock.Now})
+		require.NoError(t, err)
+
+		_, err = ids.Next()
+		require.NoError(t, err)
+
+		clock.Set(epoch.Add(900 * time.Millisecond))
+		_, err = ids.Next()
+		require.ErrorIs(t, err, random.ErrClockMovedBackwards)
+
+		clock.Set(epoch.Add(time.Second))
+		_, err = ids.Next()
+		require.NoError(t, err)
+	})
+
+	t.Run("time out of range", func(t *testing.T) {
+		t.Parallel()
+
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{
+			Epoch: epoch,
+			Now:   func() time.Time { return epoch.Add(-time.Millisecond) },
+		})
+		require.NoError(t, err)
+
+		_, err = ids.Next()
+		require.ErrorIs(t, err, random.ErrSnowflakeTimeRange)
+	})
+
+	t.Run("disabled datacenter bits", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{now: epoch.Add(time.Millisecond)}
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{
+			Epoch:          epoch,
+			DatacenterBits: -1,
+			WorkerBits:     10,
+			WorkerID:       1023,
+			Now:            clock.Now,
+		})
+		require.NoError(t, err)
+
+		id, err := ids.Next()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1)<<22|1023<<12, id)
+		assert.Equal(t, int64(1023), ids.Decode(id).WorkerID)
+		assert.Equal(t, int64(0), ids.Decode(id).DatacenterID)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		for name, cfg := range map[string]random.SnowflakeConfig{
+			"too many bits":        {WorkerBits: 10, DatacenterBits: 10},
+			"negative sequence":    {SequenceBits: -1},
+			"worker out of range":  {WorkerID: 32},
+			"negative worker":      {WorkerID: -1},
+			"datacenter too large": {DatacenterBits: 2, DatacenterID: 4},
+		} {
+			_, err := random.NewSnowflake(cfg)
+			require.ErrorIs(t, err, random.ErrInvalidSnowflakeConfig, name)
+		}
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		t.Parallel()
+
+		ids, err := random.NewSnowflake(random.SnowflakeConfig{})
+		require.NoError(t, err)
+
+		var (
+			mu   sync.Mutex
+			wg   sync.WaitGroup
+			seen = make(map[int64]bool)
+		)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 2000 {
+					id, err := ids.Next()
+					if !assert.NoError(t, err) {
+						return
+					}
+					mu.Lock()
+					seen[id] = true
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, seen, 16000)
+	})
+}
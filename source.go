@@ -0,0 +1,76 @@
+package random
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// Source is a source of uniformly distributed random uint64 values.
+// It has the same method set as the math/rand/v2 Source interface, so *rand.PCG,
+// *rand.ChaCha8 and any other math/rand/v2 source can be passed to New directly.
+type Source interface {
+	Uint64() uint64
+}
+
+// CryptoSource returns a Source backed by crypto/rand.
+// It is cryptographically secure and safe for concurrent use.
+func CryptoSource() Source {
+	return cryptoSource{}
+}
+
+// NewPCGSource returns a math/rand/v2 PCG source seeded with the given values.
+// It is fast but NOT cryptographically secure, and NOT safe for concurrent use.
+func NewPCGSource(seed1, seed2 uint64) Source {
+	return rand.NewPCG(seed1, seed2)
+}
+
+// NewChaCha8Source returns a math/rand/v2 ChaCha8 source seeded with the given key.
+// Its output is unpredictable as long as the seed is kept secret.
+// It is NOT safe for concurrent use.
+func NewChaCha8Source(seed [32]byte) Source {
+	return rand.NewChaCha8(seed)
+}
+
+// NewSeededSource returns a deterministic Source whose output depends only on seed.
+// The SplitMix64 algorithm is implemented in this package rather than taken from the
+// standard library, so a recorded seed replays the same sequence on every Go version.
+// It is NOT cryptographically secure and NOT safe for concurrent use.
+func NewSeededSource(seed uint64) Source {
+	return &seededSource{state: seed}
+}
+
+// cryptoSource reads random values from crypto/rand.
+type cryptoSource struct{}
+
+// Uint64 returns a random uint64 read from crypto/rand.
+func (cryptoSource) Uint64() uint64 {
+	var buf [8]byte
+	// crypto/rand.Read never returns an error since Go 1.24; it crashes the program
+	// irrecoverably instead of returning predictable data.
+	_, _ = cryptorand.Read(buf[:])
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// runtimeSource reads random values from the automatically seeded global
+// math/rand/v2 generator. It is safe for concurrent use.
+type runtimeSource struct{}
+
+// Uint64 returns a random uint64 from the global math/rand/v2 generator.
+func (runtimeSource) Uint64() uint64 {
+	return rand.Uint64()
+}
+
+// seededSource is a SplitMix64 generator.
+type seededSource struct {
+	state uint64
+}
+
+// Uint64 advances the state and returns the next value of the sequence.
+func (s *seededSource) Uint64() uint64 {
+	s.state += 0x9e3779b97f4a7c15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,65 @@
+package random
+
+import (
+	"context"
+	"sync"
+)
+
+// Store records issued codes so that generators can avoid collisions with codes issued
+// earlier, for example by a previous campaign or another process.
+type Store interface {
+	// Add records code and reports whether it was not present before.
+	// Implementations must check and insert atomically, so that concurrent
+	// batches never issue the same code twice.
+	Add(ctx context.Context, code string) (bool, error)
+}
+
+// MemoryStore is an in-memory Store. It is safe for concurrent use.
+type MemoryStore struct {
+	mu    sync.Mutex
+	codes map[string]struct{}
+}
+
+// NewMemoryStore returns a MemoryStore that already contains the given codes.
+//
+// Example:
+//
+//	store := random.NewMemoryStore(lastMonthCodes...)
+func NewMemoryStore(codes ...string) *MemoryStore {
+	s := &MemoryStore{codes: make(map[string]struct{}, len(codes))}
+	for _, code := range codes {
+		s.codes[code] = struct{}{}
+	}
+	return s
+}
+
+// Add records code and reports whether it was not present before.
+// It returns the context error if ctx is already done.
+func (s *MemoryStore) Add(ctx context.Context, code string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.codes[code]; ok {
+		return false, nil
+	}
+	s.codes[code] = struct{}{}
+	return true, nil
+}
+
+// Contains reports whether code has been recorded.
+func (s *MemoryStore) Contains(code string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.codes[code]
+	return ok
+}
+
+// Len returns the number of recorded codes.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.codes)
+}
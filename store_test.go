@@ -0,0 +1,71 @@
+package random_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("add and contains", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		store := random.NewMemoryStore("OLD-1")
+		assert.True(t, store.Contains("OLD-1"))
+		assert.Equal(t, 1, store.Len())
+
+		added, err := store.Add(ctx, "NEW-1")
+		require.NoError(t, err)
+		assert.True(t, added)
+
+		added, err = store.Add(ctx, "OLD-1")
+		require.NoError(t, err)
+		assert.False(t, added)
+
+		assert.Equal(t, 2, store.Len())
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := random.NewMemoryStore().Add(ctx, "code")
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("concurrent adds are atomic", func(t *testing.T) {
+		t.Parallel()
+
+		store := random.NewMemoryStore()
+		var (
+			wg    sync.WaitGroup
+			mu    sync.Mutex
+			added int
+		)
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, err := store.Add(context.Background(), "same")
+				if err == nil && ok {
+					mu.Lock()
+					added++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, added)
+	})
+}
@@ -1,8 +1,10 @@
 package random
 
 import (
-	"math/rand"
+	"io"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -13,12 +15,26 @@ const (
 	Alphanumeric = Alphabetic + Numeric
 	Symbols      = "`" + `~!@#$%^&*()-_+={}[]|\;:"<>,./?`
 	Hex          = Numeric + "abcdef"
+
+	// Ambiguous lists characters that are easily confused with one another when printed
+	// or read aloud: 0/O/o, 1/I/l, 5/S and 8/B.
+	Ambiguous = "0Oo1Il5S8B"
+	// Unambiguous is Alphanumeric without the Ambiguous characters.
+	Unambiguous = "ACDEFGHJKLMNPQRTUVWXYZ" + "abcdefghijkmnpqrstuvwxyz" + "234679"
+	// UnambiguousUpper is uppercase letters and digits without the Ambiguous characters,
+	// suitable for codes printed on receipts or read over the phone.
+	UnambiguousUpper = "ACDEFGHJKLMNPQRTUVWXYZ" + "234679"
+	// CrockfordBase32 is Douglas Crockford's Base32 alphabet, which excludes I, L, O and U.
+	CrockfordBase32 = Numeric + "ABCDEFGHJKMNPQRSTVWXYZ"
 )
 
 // String generates a random string of the specified length using the provided character sets.
 // If no character sets are provided, it defaults to Alphanumeric.
-// This function uses math/rand (automatically seeded in Go 1.20+) and is NOT cryptographically secure.
-// Use OTP() for security-sensitive operations.
+// Characters repeated across charsets are counted once, so the distribution is uniform
+// over the distinct characters.
+// Characters are picked byte by byte, so charsets must be ASCII; use RuneString for non-ASCII charsets.
+// This function uses the automatically seeded math/rand/v2 generator and is NOT cryptographically secure.
+// Use SecureString() or OTP() for security-sensitive operations.
 //
 // Example:
 //
@@ -26,13 +42,319 @@ const (
 //	random.String(32, random.Hex)              // hex string
 //	random.String(20, random.Uppercase, random.Numeric) // uppercase + digits
 func String(length uint8, charsets ...string) string {
-	charset := strings.Join(charsets, "")
-	if charset == "" {
-		charset = Alphanumeric
+	return defaultGenerator.String(length, charsets...)
+}
+
+// SecureString generates a cryptographically secure random string of the specified length
+// using the provided character sets. Character sets are combined the same way as in String,
+// and Alphanumeric is used if none are provided.
+// Characters are selected with crypto/rand using rejection sampling, so every character
+// of the combined charset is equally likely.
+// The error result is kept for API stability: crypto/rand never fails since Go 1.24.
+//
+// Example:
+//
+//	sessionID, err := random.SecureString(32)           // alphanumeric string
+//	if err != nil {
+//	    return err
+//	}
+//	apiKey, err := random.SecureString(40, random.Hex)  // hex string
+//	if err != nil {
+//	    return err
+//	}
+func SecureString(length uint8, charsets ...string) (string, error) {
+	return secureGenerator.String(length, charsets...), nil
+}
+
+// WithoutAmbiguous returns the combined character sets with the Ambiguous look-alike
+// characters removed, ready to be passed to String and friends.
+// If no character sets are provided, it starts from Alphanumeric.
+//
+// Example:
+//
+//	code := random.String(8, random.WithoutAmbiguous(random.Uppercase, random.Numeric))
+func WithoutAmbiguous(charsets ...string) string {
+	cs := NewCharset(charsets...)
+	if cs == "" {
+		cs = Alphanumeric
+	}
+	return cs.WithoutAmbiguous().String()
+}
+
+// String generates a random string of the specified length using the provided character sets.
+// If no character sets are provided, it defaults to Alphanumeric.
+// The result is cryptographically secure only if the Generator's Source is.
+func (g *Generator) String(length uint8, charsets ...string) string {
+	return g.StringN(int(length), charsets...)
+}
+
+// StringN is like String but accepts an int length, so it can produce strings longer than
+// 255 characters. Returns an empty string if length <= 0.
+// This function is NOT cryptographically secure; use SecureStringN for secrets.
+//
+// Example:
+//
+//	payload := random.StringN(1 << 20) // 1 MiB alphanumeric payload
+func StringN(length int, charsets ...string) string {
+	return defaultGenerator.StringN(length, charsets...)
+}
+
+// SecureStringN is like SecureString but accepts an int length, so it can produce secrets
+// longer than 255 characters. Returns an empty string if length <= 0.
+// The error result is kept for symmetry with SecureString and is always nil.
+func SecureStringN(length int, charsets ...string) (string, error) {
+	return secureGenerator.StringN(length, charsets...), nil
+}
+
+// StringN generates a random string of the specified length using the provided character sets,
+// drawing randomness from the Generator's Source. Returns an empty string if length <= 0.
+func (g *Generator) StringN(length int, charsets ...string) string {
+	if length <= 0 {
+		return ""
 	}
+	charset := joinCharsets(charsets)
 	b := make([]byte, length)
+	g.fillFromCharset(b, charset)
+	return string(b)
+}
+
+// WriteString writes n random characters from the provided character sets to w without
+// building the whole string in memory. If no character sets are provided, it defaults to
+// Alphanumeric. Returns the number of bytes written and the first write error, if any.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	f, _ := os.Create("payload.txt")
+//	defer f.Close()
+//	_, err := random.WriteString(f, 10<<30, random.Hex) // 10 GiB of hex
+func WriteString(w io.Writer, n int64, charsets ...string) (int64, error) {
+	return defaultGenerator.WriteString(w, n, charsets...)
+}
+
+// NewReader returns an endless io.Reader of random characters from the provided
+// character sets (Alphanumeric by default). Wrap it with io.LimitReader to bound the output.
+// The reader is NOT cryptographically secure.
+//
+// Example:
+//
+//	r := io.LimitReader(random.NewReader(random.Numeric), 1<<20)
+//	_, err := io.Copy(dst, r)
+func NewReader(charsets ...string) io.Reader {
+	return defaultGenerator.NewReader(charsets...)
+}
+
+// WriteString writes n random characters from the provided character sets to w,
+// drawing randomness from the Generator's Source.
+func (g *Generator) WriteString(w io.Writer, n int64, charsets ...string) (int64, error) {
+	if n <= 0 {
+		return 0, nil
+	}
+	return io.CopyN(w, g.NewReader(charsets...), n)
+}
+
+// NewReader returns an endless io.Reader of random characters from the provided
+// character sets, drawing randomness from the Generator's Source.
+func (g *Generator) NewReader(charsets ...string) io.Reader {
+	return &charsetReader{g: g, charset: joinCharsets(charsets)}
+}
+
+// charsetReader fills read buffers with random characters from a charset.
+type charsetReader struct {
+	g       *Generator
+	charset string
+}
+
+// Read fills p with random characters. It never returns an error.
+func (r *charsetReader) Read(p []byte) (int, error) {
+	r.g.fillFromCharset(p, r.charset)
+	return len(p), nil
+}
+
+// fillFromCharset fills b with characters picked uniformly from charset.
+func (g *Generator) fillFromCharset(b []byte, charset string) {
 	for i := range b {
-		b[i] = charset[rand.Intn(len(charset))]
+		b[i] = charset[g.IntN(len(charset))]
+	}
+}
+
+// RuneString generates a random string of length characters using the provided character sets.
+// Unlike String, character sets are treated as sequences of Unicode characters, so
+// non-ASCII charsets (Cyrillic, Greek, emoji, ...) produce valid UTF-8.
+// A character is a grapheme cluster: a base code point together with the combining marks,
+// variation selectors, emoji skin-tone modifiers and zero-width-joined code points that
+// follow it, a pair of regional indicators forming a flag, or an emoji tag sequence.
+// Such sequences are picked as a whole and never torn apart. Other multi-rune clusters,
+// such as conjoining Hangul jamo, are split into their code points.
+// Invalid UTF-8 sequences in the charsets are ignored. If no usable characters are
+// provided, it defaults to Alphanumeric. Returns an empty string if length <= 0.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	random.RuneString(8, "АБВГДЕЖЗ")     // 8 Cyrillic letters, 16 bytes
+//	random.RuneString(4, "αβγδ", "🍎🍐") // Greek letters mixed with emoji
+//	random.RuneString(3, "🇺🇦🇵🇱👍🏽")     // flags and a thumbs-up, each kept intact
+func RuneString(length int, charsets ...string) string {
+	return defaultGenerator.RuneString(length, charsets...)
+}
+
+// RuneString generates a random string of length characters using the provided character
+// sets, drawing randomness from the Generator's Source.
+// See the package-level RuneString for details.
+func (g *Generator) RuneString(length int, charsets ...string) string {
+	if length <= 0 {
+		return ""
+	}
+	return g.pickChars(length, charsetGraphemes(charsets))
+}
+
+// pickChars concatenates length characters picked uniformly from chars.
+func (g *Generator) pickChars(length int, chars []string) string {
+	var sb strings.Builder
+	sb.Grow(length * utf8.UTFMax)
+	for range length {
+		sb.WriteString(chars[g.IntN(len(chars))])
+	}
+	return sb.String()
+}
+
+// StringOf generates a random string of length characters from the given Charset values,
+// plain strings or charset constants, which lets a Charset be passed without converting it.
+// Characters are grapheme clusters like in RuneString, so non-ASCII charsets and emoji
+// sequences are supported and the result is always valid UTF-8. For ASCII charsets the output equals that of StringN.
+// This function is NOT cryptographically secure; use SecureStringOf for secrets.
+//
+// Example:
+//
+//	cs := random.MustParseCharset("a-z0-9").Minus("0o1l")
+//	code := random.StringOf(8, cs)
+//	code := random.StringOf(8, cs, random.Uppercase) // untyped constants mix with Charset
+func StringOf[C ~string](length int, charsets ...C) string {
+	return StringOfFrom(defaultGenerator, length, charsets...)
+}
+
+// SecureStringOf is like StringOf but uses crypto/rand.
+// The error result is kept for symmetry with SecureString and is always nil.
+func SecureStringOf[C ~string](length int, charsets ...C) (string, error) {
+	return StringOfFrom(secureGenerator, length, charsets...), nil
+}
+
+// StringOfFrom is like StringOf but draws randomness from g.
+// Go methods cannot have type parameters, so the Generator is passed as an argument.
+func StringOfFrom[C ~string](g *Generator, length int, charsets ...C) string {
+	return g.RuneString(length, charsetStrings(charsets)...)
+}
+
+// charsetStrings converts Charset values or other string types to plain strings.
+func charsetStrings[C ~string](charsets []C) []string {
+	strs := make([]string, len(charsets))
+	for i, charset := range charsets {
+		strs[i] = string(charset)
+	}
+	return strs
+}
+
+// charsetGraphemes splits the concatenated character sets into distinct grapheme clusters,
+// skipping invalid UTF-8 sequences, and falls back to Alphanumeric when none remain.
+func charsetGraphemes(charsets []string) []string {
+	var chars []string
+	seen := make(map[string]bool)
+	for _, charset := range charsets {
+		for charset != "" {
+			n := graphemeLen(charset)
+			if n == 0 {
+				// Invalid UTF-8 byte
+				charset = charset[1:]
+				continue
+			}
+			if c := charset[:n]; !seen[c] {
+				seen[c] = true
+				chars = append(chars, c)
+			}
+			charset = charset[n:]
+		}
+	}
+	if len(chars) == 0 {
+		for i := range len(Alphanumeric) {
+			chars = append(chars, Alphanumeric[i:i+1])
+		}
+	}
+	return chars
+}
+
+// graphemeLen returns the length in bytes of the grapheme cluster at the start of s, or 0
+// if s starts with an invalid UTF-8 byte. It covers the emoji and combining sequences of
+// Unicode extended grapheme clusters (UAX #29) but not Hangul syllable composition.
+func graphemeLen(s string) int {
+	r, n := decodeValidRune(s)
+	if n == 0 {
+		return 0
+	}
+	if isRegionalIndicator(r) {
+		// Two regional indicators form a flag, such as 🇺🇦 from U+1F1FA U+1F1E6
+		if r2, m := decodeValidRune(s[n:]); isRegionalIndicator(r2) {
+			n += m
+		}
+	}
+	for n < len(s) {
+		r, m := decodeValidRune(s[n:])
+		switch {
+		case r == zeroWidthJoiner:
+			// The joiner glues the next code point to the cluster, as in 👨‍👩‍👧
+			n += m
+			_, m = decodeValidRune(s[n:])
+			n += m
+		case m > 0 && isGraphemeExtend(r):
+			n += m
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// zeroWidthJoiner (ZWJ) joins emoji into a single glyph.
+const zeroWidthJoiner = '\u200d'
+
+// decodeValidRune is utf8.DecodeRuneInString that reports invalid UTF-8 and an empty s
+// with a size of 0, while keeping a literal U+FFFD.
+func decodeValidRune(s string) (rune, int) {
+	r, n := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError && n <= 1 {
+		return r, 0
+	}
+	return r, n
+}
+
+// isRegionalIndicator reports whether r is one of the letters used to spell flag emoji.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isGraphemeExtend reports whether r attaches to the preceding character: combining marks,
+// which include variation selectors, emoji skin-tone modifiers and emoji tag characters.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		(r >= 0x1f3fb && r <= 0x1f3ff) ||
+		(r >= 0xe0020 && r <= 0xe007f)
+}
+
+// joinCharsets concatenates the given character sets, dropping repeated bytes so every
+// distinct character is equally likely, and falls back to Alphanumeric when the result is empty.
+func joinCharsets(charsets []string) string {
+	var seen [256]bool
+	b := make([]byte, 0, len(Alphanumeric))
+	for _, charset := range charsets {
+		for i := 0; i < len(charset); i++ {
+			if c := charset[i]; !seen[c] {
+				seen[c] = true
+				b = append(b, c)
+			}
+		}
+	}
+	if len(b) == 0 {
+		return Alphanumeric
 	}
 	return string(b)
 }
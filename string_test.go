@@ -1,9 +1,13 @@
 package random_test
 
 import (
+	"errors"
+	"io"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -308,3 +312,345 @@ func TestString_Constants(t *testing.T) {
 		assert.Equal(t, 31, len(random.Symbols))
 	})
 }
+
+func TestSecureString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		length := uint8(32)
+		result, err := random.SecureString(length)
+		require.NoError(t, err)
+
+		require.Equal(t, int(length), len(result))
+
+		alphanumericRegex := regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+		require.True(t, alphanumericRegex.MatchString(result))
+	})
+
+	t.Run("hex only", func(t *testing.T) {
+		t.Parallel()
+
+		length := uint8(40)
+		result, err := random.SecureString(length, random.Hex)
+		require.NoError(t, err)
+
+		require.Equal(t, int(length), len(result))
+
+		hexRegex := regexp.MustCompile(`^[0-9a-f]+$`)
+		require.True(t, hexRegex.MatchString(result))
+	})
+
+	t.Run("multiple charsets combined", func(t *testing.T) {
+		t.Parallel()
+
+		length := uint8(30)
+		result, err := random.SecureString(length, random.Uppercase, random.Numeric)
+		require.NoError(t, err)
+
+		require.Equal(t, int(length), len(result))
+
+		combinedRegex := regexp.MustCompile(`^[A-Z0-9]+$`)
+		require.True(t, combinedRegex.MatchString(result))
+	})
+
+	t.Run("empty charset defaults to alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := random.SecureString(20, "")
+		require.NoError(t, err)
+
+		alphanumericRegex := regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+		require.True(t, alphanumericRegex.MatchString(result))
+	})
+
+	t.Run("zero length", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := random.SecureString(0)
+		require.NoError(t, err)
+		require.Equal(t, "", result)
+	})
+
+	t.Run("randomness check", func(t *testing.T) {
+		t.Parallel()
+
+		results := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			result, err := random.SecureString(20)
+			require.NoError(t, err)
+			results[result] = true
+		}
+
+		require.Equal(t, 100, len(results))
+	})
+
+	t.Run("uniform distribution", func(t *testing.T) {
+		t.Parallel()
+
+		// 3 does not divide 2^64, so a naive modulo would be biased
+		counts := make(map[rune]int)
+		for i := 0; i < 20; i++ {
+			result, err := random.SecureString(255, "abc")
+			require.NoError(t, err)
+			for _, char := range result {
+				counts[char]++
+			}
+		}
+
+		require.Len(t, counts, 3)
+		for _, count := range counts {
+			require.InDelta(t, 1700, count, 250)
+		}
+	})
+}
+
+func TestRuneString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cyrillic charset", func(t *testing.T) {
+		t.Parallel()
+
+		charset := "АБВГДЕЖЗИКЛМН"
+		result := random.RuneString(20, charset)
+
+		require.True(t, utf8.ValidString(result))
+		require.Equal(t, 20, utf8.RuneCountInString(result))
+		for _, char := range result {
+			require.True(t, strings.ContainsRune(charset, char))
+		}
+	})
+
+	t.Run("mixed width charsets", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(50, "αβγδ", "🍎🍐", "xyz")
+
+		require.True(t, utf8.ValidString(result))
+		require.Equal(t, 50, utf8.RuneCountInString(result))
+		for _, char := range result {
+			require.True(t, strings.ContainsRune("αβγδ🍎🍐xyz", char))
+		}
+	})
+
+	t.Run("multi-rune emoji are kept intact", func(t *testing.T) {
+		t.Parallel()
+
+		// Flags, a skin-tone modifier, a ZWJ family, a keycap, a tag sequence and a combining accent
+		chars := []string{"🇺🇦", "🇵🇱", "👍🏽", "👨\u200d👩\u200d👧", "1\ufe0f\u20e3", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "e\u0301"}
+		g := random.NewSeeded(1)
+		for range 50 {
+			result := g.RuneString(4, strings.Join(chars, ""))
+			require.True(t, utf8.ValidString(result))
+
+			rest := result
+			for count := 0; rest != ""; count++ {
+				require.Less(t, count, 4, result)
+				i := slices.IndexFunc(chars, func(c string) bool { return strings.HasPrefix(rest, c) })
+				require.GreaterOrEqual(t, i, 0, "%q contains a character outside the charset", result)
+				rest = strings.TrimPrefix(rest, chars[i])
+			}
+		}
+
+		// The regional indicators U+1F1E6 and U+1F1F1 of an Albanian flag must not be recombined
+		assert.NotContains(t, random.NewSeeded(1).RuneString(100, "🇺🇦🇵🇱"), "🇦🇱")
+	})
+
+	t.Run("default alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(16)
+
+		alphanumericRegex := regexp.MustCompile(`^[a-zA-Z0-9]{16}$`)
+		require.True(t, alphanumericRegex.MatchString(result))
+	})
+
+	t.Run("invalid utf-8 is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(30, "a\xffb\xfe")
+
+		require.True(t, utf8.ValidString(result))
+		require.Equal(t, 30, utf8.RuneCountInString(result))
+		for _, char := range result {
+			require.True(t, char == 'a' || char == 'b')
+		}
+	})
+
+	t.Run("replacement character is kept", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(5, "�")
+		require.Equal(t, strings.Repeat("�", 5), result)
+	})
+
+	t.Run("only invalid bytes defaults to alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(10, "\xff\xfe")
+
+		alphanumericRegex := regexp.MustCompile(`^[a-zA-Z0-9]{10}$`)
+		require.True(t, alphanumericRegex.MatchString(result))
+	})
+
+	t.Run("non-positive length", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "", random.RuneString(0, "абв"))
+		require.Equal(t, "", random.RuneString(-1, "абв"))
+	})
+
+	t.Run("length beyond 255", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.RuneString(1000, "ж")
+		require.Equal(t, 1000, utf8.RuneCountInString(result))
+	})
+}
+
+func TestStringN(t *testing.T) {
+	t.Parallel()
+
+	t.Run("length beyond uint8", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.StringN(10000, random.Hex)
+
+		require.Equal(t, 10000, len(result))
+		hexRegex := regexp.MustCompile(`^[0-9a-f]+$`)
+		require.True(t, hexRegex.MatchString(result))
+	})
+
+	t.Run("default alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		result := random.StringN(300)
+
+		alphanumericRegex := regexp.MustCompile(`^[a-zA-Z0-9]{300}$`)
+		require.True(t, alphanumericRegex.MatchString(result))
+	})
+
+	t.Run("non-positive length", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "", random.StringN(0))
+		require.Equal(t, "", random.StringN(-10))
+	})
+
+	t.Run("secure variant", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := random.SecureStringN(512, random.Uppercase)
+		require.NoError(t, err)
+
+		uppercaseRegex := regexp.MustCompile(`^[A-Z]{512}$`)
+		require.True(t, uppercaseRegex.MatchString(result))
+	})
+}
+
+func TestWriteString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes exact number of characters", func(t *testing.T) {
+		t.Parallel()
+
+		var buf strings.Builder
+		n, err := random.WriteString(&buf, 100000, random.Numeric)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(100000), n)
+		require.Equal(t, 100000, buf.Len())
+		numericRegex := regexp.MustCompile(`^[0-9]+$`)
+		require.True(t, numericRegex.MatchString(buf.String()))
+	})
+
+	t.Run("zero length", func(t *testing.T) {
+		t.Parallel()
+
+		var buf strings.Builder
+		n, err := random.WriteString(&buf, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), n)
+		require.Equal(t, "", buf.String())
+	})
+
+	t.Run("propagates write errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.WriteString(failingWriter{}, 10)
+		require.ErrorIs(t, err, errWriteFailed)
+	})
+
+	t.Run("seeded generator matches StringN", func(t *testing.T) {
+		t.Parallel()
+
+		var buf strings.Builder
+		_, err := random.NewSeeded(9).WriteString(&buf, 64, random.Hex)
+		require.NoError(t, err)
+
+		require.Equal(t, random.NewSeeded(9).StringN(64, random.Hex), buf.String())
+	})
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+
+	data, err := io.ReadAll(io.LimitReader(random.NewReader(random.Lowercase), 5000))
+	require.NoError(t, err)
+
+	require.Equal(t, 5000, len(data))
+	lowercaseRegex := regexp.MustCompile(`^[a-z]+$`)
+	require.True(t, lowercaseRegex.Match(data))
+}
+
+var errWriteFailed = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWriteFailed
+}
+
+func TestUnambiguousCharsets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("verify preset values", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, random.Charset(random.Alphanumeric).Minus(random.Ambiguous).String(), random.Unambiguous)
+		assert.Equal(t, random.NewCharset(random.Uppercase, random.Numeric).Minus(random.Ambiguous).String(), random.UnambiguousUpper)
+		assert.Equal(t, "0123456789ABCDEFGHJKMNPQRSTVWXYZ", random.CrockfordBase32)
+		assert.Equal(t, 32, len(random.CrockfordBase32))
+	})
+
+	t.Run("presets exclude look-alikes", func(t *testing.T) {
+		t.Parallel()
+
+		for _, preset := range []string{random.Unambiguous, random.UnambiguousUpper} {
+			assert.False(t, strings.ContainsAny(preset, random.Ambiguous))
+		}
+	})
+
+	t.Run("without ambiguous", func(t *testing.T) {
+		t.Parallel()
+
+		charset := random.WithoutAmbiguous(random.Uppercase, random.Numeric)
+		assert.Equal(t, random.UnambiguousUpper, charset)
+
+		result := random.String(200, charset)
+		assert.False(t, strings.ContainsAny(result, random.Ambiguous))
+	})
+
+	t.Run("without ambiguous defaults to alphanumeric", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, random.Unambiguous, random.WithoutAmbiguous())
+	})
+
+	t.Run("without ambiguous keeps multibyte runes", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "ЖΩx", random.WithoutAmbiguous("ЖΩ0x"))
+	})
+}
@@ -0,0 +1,265 @@
+package random
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidToken is returned when a token cannot be decoded with the given encoding.
+	ErrInvalidToken = errors.New("random: invalid token")
+	// ErrUnknownEncoding is returned for Encoding values that are not defined by this package.
+	ErrUnknownEncoding = errors.New("random: unknown encoding")
+)
+
+// defaultTokenBytes is used when Token is called with a non-positive byte count.
+const defaultTokenBytes = 32
+
+const (
+	// base58Alphabet is the Bitcoin base58 alphabet, which omits 0, O, I and l.
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// base62Alphabet orders digits before letters so that encodings sort like the numbers
+	// they represent.
+	base62Alphabet = Numeric + Uppercase + Lowercase
+)
+
+var (
+	base32NoPadding          = base32.StdEncoding.WithPadding(base32.NoPadding)
+	base32CrockfordNoPadding = base32.NewEncoding(CrockfordBase32).WithPadding(base32.NoPadding)
+	crockfordNormalizer      = strings.NewReplacer("-", "", "I", "1", "L", "1", "O", "0")
+)
+
+// Encoding selects the text encoding of a token.
+type Encoding int
+
+const (
+	// EncodingHex is lowercase hexadecimal.
+	EncodingHex Encoding = iota
+	// EncodingBase32 is RFC 4648 base32 without padding.
+	EncodingBase32
+	// EncodingBase32Crockford is Crockford's base32 without padding. Decoding is
+	// case-insensitive, ignores hyphens and maps I and L to 1 and O to 0.
+	EncodingBase32Crockford
+	// EncodingBase58 uses the Bitcoin alphabet; leading zero bytes are encoded as '1'.
+	EncodingBase58
+	// EncodingBase62 uses the alphabet 0-9A-Za-z; leading zero bytes are encoded as '0'.
+	EncodingBase62
+	// EncodingBase64URL is RFC 4648 URL-safe base64 without padding.
+	EncodingBase64URL
+)
+
+// String returns the name of the encoding.
+func (e Encoding) String() string {
+	switch e {
+	case EncodingHex:
+		return "hex"
+	case EncodingBase32:
+		return "base32"
+	case EncodingBase32Crockford:
+		return "base32-crockford"
+	case EncodingBase58:
+		return "base58"
+	case EncodingBase62:
+		return "base62"
+	case EncodingBase64URL:
+		return "base64url"
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+// Token generates nBytes of cryptographically secure random data (default 32) and returns
+// them in the given text encoding. All encodings are URL-safe.
+// Returns ErrUnknownEncoding for encodings not defined by this package.
+//
+// Example:
+//
+//	token, err := random.Token(32, random.EncodingBase64URL) // 43 characters
+//	token, err := random.Token(16, random.EncodingBase58)    // ~22 characters
+func Token(nBytes int, encoding Encoding) (string, error) {
+	return secureGenerator.Token(nBytes, encoding)
+}
+
+// Token generates nBytes of random data from the Generator's Source and returns them in
+// the given encoding. See the package-level Token for details.
+func (g *Generator) Token(nBytes int, encoding Encoding) (string, error) {
+	if nBytes <= 0 {
+		nBytes = defaultTokenBytes
+	}
+	b := make([]byte, nBytes)
+	_, _ = g.Read(b)
+	return EncodeToken(b, encoding)
+}
+
+// TokenForEntropy is like Token but generates enough random bytes for at least the given
+// bits of entropy, rounded up to whole bytes. A non-positive target uses the default of 32 bytes.
+// Returns ErrEntropyTooHigh if the target needs more than 65536 bytes.
+//
+// Example:
+//
+//	token, err := random.TokenForEntropy(128, random.EncodingBase58) // 16 bytes, ~22 characters
+func TokenForEntropy(bits float64, encoding Encoding) (string, error) {
+	return secureGenerator.TokenForEntropy(bits, encoding)
+}
+
+// TokenForEntropy generates a token with at least the given bits of entropy from the
+// Generator's Source. See the package-level TokenForEntropy for details.
+func (g *Generator) TokenForEntropy(bits float64, encoding Encoding) (string, error) {
+	nBytes, err := lengthForEntropy(bits, 8, maxEntropyLength)
+	if err != nil {
+		return "", err
+	}
+	return g.Token(nBytes, encoding)
+}
+
+// EncodeToken returns b in the given text encoding.
+// Returns ErrUnknownEncoding for encodings not defined by this package.
+func EncodeToken(b []byte, encoding Encoding) (string, error) {
+	switch encoding {
+	case EncodingHex:
+		return hex.EncodeToString(b), nil
+	case EncodingBase32:
+		return base32NoPadding.EncodeToString(b), nil
+	case EncodingBase32Crockford:
+		return base32CrockfordNoPadding.EncodeToString(b), nil
+	case EncodingBase58:
+		return encodeBaseN(b, base58Alphabet), nil
+	case EncodingBase62:
+		return encodeBaseN(b, base62Alphabet), nil
+	case EncodingBase64URL:
+		return base64.RawURLEncoding.EncodeToString(b), nil
+	}
+	return "", fmt.Errorf("%w: %v", ErrUnknownEncoding, encoding)
+}
+
+// DecodeToken decodes a token produced by Token or EncodeToken back into its bytes.
+// Decoding is strict: apart from the Crockford normalization, only the exact output of
+// EncodeToken is accepted, so every byte slice has a single valid token.
+// Returns ErrInvalidToken if the token is not valid in the given encoding.
+func DecodeToken(token string, encoding Encoding) ([]byte, error) {
+	var (
+		b   []byte
+		err error
+	)
+	switch encoding {
+	case EncodingHex:
+		b, err = hex.DecodeString(token)
+	case EncodingBase32:
+		b, err = decodeBase32Strict(base32NoPadding, token)
+	case EncodingBase32Crockford:
+		b, err = decodeBase32Strict(base32CrockfordNoPadding, crockfordNormalizer.Replace(strings.ToUpper(token)))
+	case EncodingBase58:
+		b, err = decodeBaseN(token, base58Alphabet)
+	case EncodingBase62:
+		b, err = decodeBaseN(token, base62Alphabet)
+	case EncodingBase64URL:
+		b, err = base64.RawURLEncoding.Strict().DecodeString(token)
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownEncoding, encoding)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return b, nil
+}
+
+// ValidateToken reports whether token is valid in the given encoding and, if nBytes > 0,
+// decodes to exactly nBytes bytes.
+//
+// Example:
+//
+//	if !random.ValidateToken(token, random.EncodingBase64URL, 32) {
+//	    return errors.New("malformed token")
+//	}
+func ValidateToken(token string, encoding Encoding, nBytes int) bool {
+	b, err := DecodeToken(token, encoding)
+	if err != nil {
+		return false
+	}
+	return nBytes <= 0 || len(b) == nBytes
+}
+
+// decodeBase32Strict decodes s and rejects it unless it is exactly the encoding of the
+// result. encoding/base32 has no Strict mode: it ignores set bits in the unused tail of the
+// last character and skips newlines, so "AA" and "AB" would both decode to [0x00].
+func decodeBase32Strict(enc *base32.Encoding, s string) ([]byte, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if enc.EncodeToString(b) != s {
+		return nil, errors.New("non-canonical base32")
+	}
+	return b, nil
+}
+
+// encodeBaseN encodes b as a big-endian number in the base of the alphabet.
+// Each leading zero byte is encoded as the first character of the alphabet, so the
+// encoding round-trips byte slices of any length.
+func encodeBaseN(b []byte, alphabet string) string {
+	base := len(alphabet)
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	// Little-endian digits of the number, built by repeated multiply-and-add
+	digits := make([]byte, 0, len(b)*2)
+	for _, c := range b[zeros:] {
+		carry := int(c)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % base)
+			carry /= base
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%base))
+			carry /= base
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i := range zeros {
+		out[i] = alphabet[0]
+	}
+	for i, d := range digits {
+		out[len(out)-1-i] = alphabet[d]
+	}
+	return string(out)
+}
+
+// decodeBaseN is the inverse of encodeBaseN.
+func decodeBaseN(s, alphabet string) ([]byte, error) {
+	base := len(alphabet)
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	// Little-endian bytes of the number
+	var num []byte
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(alphabet, s[i])
+		if carry < 0 {
+			return nil, fmt.Errorf("illegal character %q at offset %d", s[i], i)
+		}
+		for j := range num {
+			carry += int(num[j]) * base
+			num[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			num = append(num, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	out := make([]byte, zeros+len(num))
+	for i, c := range num {
+		out[len(out)-1-i] = c
+	}
+	return out, nil
+}
@@ -0,0 +1,240 @@
+package random_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+var allEncodings = []random.Encoding{
+	random.EncodingHex,
+	random.EncodingBase32,
+	random.EncodingBase32Crockford,
+	random.EncodingBase58,
+	random.EncodingBase62,
+	random.EncodingBase64URL,
+}
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		urlSafe := regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+		for _, encoding := range allEncodings {
+			for _, n := range []int{1, 16, 32, 64} {
+				token, err := random.Token(n, encoding)
+				require.NoError(t, err, encoding)
+				require.Regexp(t, urlSafe, token, encoding)
+
+				b, err := random.DecodeToken(token, encoding)
+				require.NoError(t, err, encoding)
+				require.Len(t, b, n, encoding)
+				require.True(t, random.ValidateToken(token, encoding, n), encoding)
+			}
+		}
+	})
+
+	t.Run("default size", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := random.Token(0, random.EncodingHex)
+		require.NoError(t, err)
+		require.Len(t, token, 64)
+	})
+
+	t.Run("expected lengths", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := random.Token(32, random.EncodingBase64URL)
+		require.NoError(t, err)
+		assert.Len(t, token, 43)
+
+		token, err = random.Token(20, random.EncodingBase32)
+		require.NoError(t, err)
+		assert.Len(t, token, 32)
+	})
+
+	t.Run("for entropy", func(t *testing.T) {
+		t.Parallel()
+
+		// Whole bytes: 129 bits need 17 bytes
+		for bits, nBytes := range map[float64]int{128: 16, 129: 17, 0: 32} {
+			token, err := random.TokenForEntropy(bits, random.EncodingHex)
+			require.NoError(t, err)
+			assert.Len(t, token, 2*nBytes, bits)
+		}
+
+		_, err := random.TokenForEntropy(1e12, random.EncodingHex)
+		require.ErrorIs(t, err, random.ErrEntropyTooHigh)
+
+		_, err = random.TokenForEntropy(128, random.Encoding(99))
+		require.ErrorIs(t, err, random.ErrUnknownEncoding)
+	})
+
+	t.Run("unknown encoding", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := random.Token(16, random.Encoding(99))
+		require.ErrorIs(t, err, random.ErrUnknownEncoding)
+
+		_, err = random.DecodeToken("abc", random.Encoding(99))
+		require.ErrorIs(t, err, random.ErrUnknownEncoding)
+	})
+
+	t.Run("randomness check", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			token, err := random.Token(16, random.EncodingBase62)
+			require.NoError(t, err)
+			tokens[token] = true
+		}
+		require.Len(t, tokens, 100)
+	})
+}
+
+func TestEncodeToken(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("Hello World!")
+	tests := []struct {
+		encoding random.Encoding
+		want     string
+	}{
+		{random.EncodingHex, "48656c6c6f20576f726c6421"},
+		{random.EncodingBase32, "JBSWY3DPEBLW64TMMQQQ"},
+		{random.EncodingBase58, "2NEpo7TZRRrLZSi2U"},
+		{random.EncodingBase62, "T8dgcjRGkZ3aysdN"},
+		{random.EncodingBase64URL, "SGVsbG8gV29ybGQh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.encoding.String(), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := random.EncodeToken(data, tt.encoding)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded, err := random.DecodeToken(tt.want, tt.encoding)
+			require.NoError(t, err)
+			assert.Equal(t, data, decoded)
+		})
+	}
+
+	t.Run("leading zero bytes", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte{0, 0, 0xff}
+		for _, encoding := range allEncodings {
+			token, err := random.EncodeToken(data, encoding)
+			require.NoError(t, err)
+
+			decoded, err := random.DecodeToken(token, encoding)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(data, decoded), encoding)
+		}
+
+		token, err := random.EncodeToken(data, random.EncodingBase62)
+		require.NoError(t, err)
+		assert.Equal(t, "0047", token)
+	})
+}
+
+func TestDecodeToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("crockford normalization", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := random.EncodeToken([]byte{0x08, 0x42, 0x10, 0x84, 0x21}, random.EncodingBase32Crockford)
+		require.NoError(t, err)
+		require.Equal(t, "11111111", token)
+
+		for _, variant := range []string{"iIlL1111", "1111-1111", "11111111"} {
+			b, err := random.DecodeToken(variant, random.EncodingBase32Crockford)
+			require.NoError(t, err, variant)
+			require.Equal(t, []byte{0x08, 0x42, 0x10, 0x84, 0x21}, b)
+		}
+	})
+
+	t.Run("non-canonical base32", func(t *testing.T) {
+		t.Parallel()
+
+		// Two characters carry 10 bits, so only the encoding with the 2 unused bits cleared is valid
+		for encoding, tc := range map[random.Encoding]struct {
+			canonical string
+			others    []string
+		}{
+			random.EncodingBase32:          {"AA", []string{"AB", "AC", "A7"}},
+			random.EncodingBase32Crockford: {"00", []string{"01", "02", "0Z"}},
+		} {
+			b, err := random.DecodeToken(tc.canonical, encoding)
+			require.NoError(t, err, encoding)
+			require.Equal(t, []byte{0x00}, b)
+
+			for _, token := range tc.others {
+				_, err := random.DecodeToken(token, encoding)
+				require.ErrorIs(t, err, random.ErrInvalidToken, token)
+				require.False(t, random.ValidateToken(token, encoding, 1), token)
+			}
+		}
+
+		_, err := random.DecodeToken("AA\nAA", random.EncodingBase32)
+		require.ErrorIs(t, err, random.ErrInvalidToken)
+
+		// Crockford normalization still applies before the comparison
+		b, err := random.DecodeToken("o-O", random.EncodingBase32Crockford)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x00}, b)
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := map[random.Encoding]string{
+			random.EncodingHex:             "xyz",
+			random.EncodingBase32:          "a!",
+			random.EncodingBase32Crockford: "UUUU",
+			random.EncodingBase58:          "0OIl",
+			random.EncodingBase62:          "abc-",
+			random.EncodingBase64URL:       "ab+/",
+		}
+		for encoding, token := range invalid {
+			_, err := random.DecodeToken(token, encoding)
+			require.ErrorIs(t, err, random.ErrInvalidToken, encoding)
+			require.False(t, random.ValidateToken(token, encoding, 0), encoding)
+		}
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := random.Token(16, random.EncodingBase58)
+		require.NoError(t, err)
+		require.True(t, random.ValidateToken(token, random.EncodingBase58, 0))
+		require.False(t, random.ValidateToken(token, random.EncodingBase58, 32))
+	})
+}
+
+func TestGenerator_Read(t *testing.T) {
+	t.Parallel()
+
+	g := random.NewSeeded(1)
+	a := make([]byte, 13)
+	n, err := g.Read(a)
+	require.NoError(t, err)
+	require.Equal(t, 13, n)
+
+	b := make([]byte, 13)
+	_, _ = random.NewSeeded(1).Read(b)
+	require.Equal(t, a, b)
+}
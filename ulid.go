@@ -0,0 +1,230 @@
+package random
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidULID is returned when a ULID cannot be parsed.
+	ErrInvalidULID = errors.New("random: invalid ULID")
+	// ErrULIDOverflow is returned by monotonic ULID generation when the 80-bit entropy of
+	// the current millisecond is exhausted.
+	ErrULIDOverflow = errors.New("random: monotonic ULID entropy overflow")
+)
+
+const (
+	// ulidEncodedLength is the length of the Crockford base32 text form of a ULID.
+	ulidEncodedLength = 26
+	// ulidMaxTime is the largest timestamp representable in 48 bits.
+	ulidMaxTime = 1<<48 - 1
+)
+
+// ULID is a Universally Unique Lexicographically Sortable Identifier: a 48-bit Unix
+// millisecond timestamp followed by 80 bits of randomness, written as 26 Crockford base32
+// characters.
+type ULID [16]byte
+
+// defaultULIDs backs NewMonotonicULID.
+var defaultULIDs = NewULIDGenerator(secureGenerator)
+
+// NewULID returns a ULID for the current time with 80 bits of crypto/rand entropy.
+// ULIDs created within the same millisecond are unordered; use NewMonotonicULID when
+// their order matters.
+//
+// Example:
+//
+//	id := random.NewULID() // "01J9ZQ3K8X4V6B2N7M5C1D9E0F"
+func NewULID() ULID {
+	return secureGenerator.NewULID(time.Now())
+}
+
+// NewULID returns a ULID for t, drawing the entropy from the Generator's Source.
+// Times before the Unix epoch or after the year 10889 are clamped to the representable range.
+func (g *Generator) NewULID(t time.Time) ULID {
+	var id ULID
+	id.setTime(t)
+	g.Read(id[6:])
+	return id
+}
+
+// NewMonotonicULID returns a ULID for the current time that sorts after every ULID
+// previously returned by NewMonotonicULID. Within the same millisecond the entropy of the
+// previous ULID is incremented by one instead of being drawn afresh.
+// Returns ErrULIDOverflow in the practically unreachable case that the entropy overflows.
+// Safe for concurrent use.
+//
+// Example:
+//
+//	id, err := random.NewMonotonicULID()
+//	if err != nil {
+//	    return err
+//	}
+func NewMonotonicULID() (ULID, error) {
+	return defaultULIDs.Next(time.Now())
+}
+
+// ULIDGenerator generates strictly increasing ULIDs.
+// It is safe for concurrent use if its Generator's Source is.
+type ULIDGenerator struct {
+	g    *Generator
+	mu   sync.Mutex
+	last ULID
+}
+
+// NewULIDGenerator returns a monotonic ULID generator drawing entropy from g.
+// If g is nil, crypto/rand is used.
+//
+// Example:
+//
+//	ids := random.NewULIDGenerator(random.NewSeeded(42)) // reproducible ULIDs
+//	id, err := ids.Next(time.UnixMilli(1700000000000))
+func NewULIDGenerator(g *Generator) *ULIDGenerator {
+	if g == nil {
+		g = secureGenerator
+	}
+	return &ULIDGenerator{g: g}
+}
+
+// Next returns a ULID for t that sorts after every ULID previously returned by the
+// generator. If t falls in the same millisecond as the previous ULID, or earlier because
+// the clock moved backwards, the previous timestamp is kept and its entropy is incremented.
+// Returns ErrULIDOverflow when the entropy of that millisecond is exhausted.
+func (u *ULIDGenerator) Next(t time.Time) (ULID, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	id := u.g.NewULID(t)
+	if u.last != (ULID{}) && id.Timestamp() <= u.last.Timestamp() {
+		id = u.last
+		if !id.incrementEntropy() {
+			return ULID{}, ErrULIDOverflow
+		}
+	}
+	u.last = id
+	return id, nil
+}
+
+// ParseULID parses the 26-character Crockford base32 form of a ULID. Decoding is
+// case-insensitive. Returns ErrInvalidULID for malformed input or values above
+// "7ZZZZZZZZZZZZZZZZZZZZZZZZZ".
+//
+// Example:
+//
+//	id, err := random.ParseULID("01J9ZQ3K8X4V6B2N7M5C1D9E0F")
+//	if err != nil {
+//	    return err
+//	}
+//	created := id.Time()
+func ParseULID(s string) (ULID, error) {
+	if len(s) != ulidEncodedLength {
+		return ULID{}, fmt.Errorf("%w: unexpected length %d", ErrInvalidULID, len(s))
+	}
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		v := crockfordValue(s[i])
+		if v < 0 {
+			return ULID{}, fmt.Errorf("%w: illegal character %q", ErrInvalidULID, s[i])
+		}
+		if i == 0 && v > 7 {
+			return ULID{}, fmt.Errorf("%w: value overflows 128 bits", ErrInvalidULID)
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+
+	var id ULID
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return id, nil
+}
+
+// String returns the 26-character uppercase Crockford base32 form of the ULID.
+func (id ULID) String() string {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	var b [ulidEncodedLength]byte
+	for i := range b {
+		// The 128 bits are encoded as 130 bits with two leading zero bits
+		shift := uint(125 - 5*i)
+		var v uint64
+		switch {
+		case shift >= 64:
+			v = hi >> (shift - 64)
+		case shift > 59:
+			v = lo>>shift | hi<<(64-shift)
+		default:
+			v = lo >> shift
+		}
+		b[i] = CrockfordBase32[v&31]
+	}
+	return string(b[:])
+}
+
+// Bytes returns a copy of the 16 bytes of the ULID.
+func (id ULID) Bytes() []byte {
+	return id[:]
+}
+
+// Timestamp returns the Unix millisecond timestamp of the ULID.
+func (id ULID) Timestamp() uint64 {
+	return binary.BigEndian.Uint64(id[:8]) >> 16
+}
+
+// Time returns the creation time of the ULID with millisecond precision.
+func (id ULID) Time() time.Time {
+	return time.UnixMilli(int64(id.Timestamp()))
+}
+
+// MarshalText implements encoding.TextMarshaler using the Crockford base32 form.
+func (id ULID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and accepts the form of ParseULID.
+func (id *ULID) UnmarshalText(text []byte) error {
+	parsed, err := ParseULID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// setTime stores the millisecond timestamp of t in the first 48 bits.
+func (id *ULID) setTime(t time.Time) {
+	ms := max(t.UnixMilli(), 0)
+	ms = min(ms, ulidMaxTime)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(ms))
+	copy(id[:6], b[2:])
+}
+
+// incrementEntropy adds one to the 80-bit entropy and reports whether it did not overflow.
+func (id *ULID) incrementEntropy() bool {
+	for i := len(id) - 1; i >= 6; i-- {
+		id[i]++
+		if id[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// crockfordValue returns the value of a Crockford base32 digit, accepting lowercase
+// letters, or -1 if c is not a digit.
+func crockfordValue(c byte) int {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	for i := 0; i < len(CrockfordBase32); i++ {
+		if CrockfordBase32[i] == c {
+			return i
+		}
+	}
+	return -1
+}
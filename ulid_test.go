@@ -0,0 +1,204 @@
+package random_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestNewULID(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now().Truncate(time.Millisecond)
+	id := random.NewULID()
+	after := time.Now()
+
+	require.Regexp(t, `^[0-7][`+random.CrockfordBase32+`]{25}$`, id.String())
+	assert.False(t, id.Time().Before(before))
+	assert.False(t, id.Time().After(after))
+
+	t.Run("seeded generator", func(t *testing.T) {
+		t.Parallel()
+
+		ts := time.UnixMilli(1469922850259)
+		a := random.NewSeeded(1).NewULID(ts)
+		b := random.NewSeeded(1).NewULID(ts)
+		assert.Equal(t, a, b)
+		assert.Equal(t, "01ARZ3NDEK", a.String()[:10])
+		assert.Equal(t, uint64(1469922850259), a.Timestamp())
+	})
+
+	t.Run("sorts by time", func(t *testing.T) {
+		t.Parallel()
+
+		g := random.NewSeeded(2)
+		earlier := g.NewULID(time.UnixMilli(1000))
+		later := g.NewULID(time.UnixMilli(1001))
+		assert.Less(t, earlier.String(), later.String())
+	})
+
+	t.Run("clamps out of range times", func(t *testing.T) {
+		t.Parallel()
+
+		g := random.NewSeeded(3)
+		assert.Equal(t, uint64(0), g.NewULID(time.UnixMilli(-5)).Timestamp())
+		assert.Equal(t, uint64(1<<48-1), g.NewULID(time.UnixMilli(1<<50)).Timestamp())
+	})
+}
+
+func TestULIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("increments within a millisecond", func(t *testing.T) {
+		t.Parallel()
+
+		ids := random.NewULIDGenerator(random.NewSeeded(42))
+		ts := time.UnixMilli(1700000000000)
+
+		first, err := ids.Next(ts)
+		require.NoError(t, err)
+		second, err := ids.Next(ts)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.Timestamp(), second.Timestamp())
+		assert.Less(t, first.String(), second.String())
+		assert.Equal(t, first.Bytes()[:15], second.Bytes()[:15], "entropy should be incremented by one")
+		assert.Equal(t, first.Bytes()[15]+1, second.Bytes()[15])
+	})
+
+	t.Run("clock moving backwards", func(t *testing.T) {
+		t.Parallel()
+
+		ids := random.NewULIDGenerator(nil)
+		first, err := ids.Next(time.UnixMilli(2000))
+		require.NoError(t, err)
+		second, err := ids.Next(time.UnixMilli(1000))
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(2000), second.Timestamp())
+		assert.Less(t, first.String(), second.String())
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		t.Parallel()
+
+		ids := random.NewULIDGenerator(random.New(constSource(^uint64(0))))
+		ts := time.UnixMilli(1000)
+
+		_, err := ids.Next(ts)
+		require.NoError(t, err)
+		_, err = ids.Next(ts)
+		require.ErrorIs(t, err, random.ErrULIDOverflow)
+
+		// A new millisecond starts from fresh entropy
+		_, err = ids.Next(ts.Add(time.Millisecond))
+		require.NoError(t, err)
+	})
+
+	t.Run("package level is strictly increasing", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu  sync.Mutex
+			wg  sync.WaitGroup
+			all []string
+		)
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				prev := ""
+				for range 2000 {
+					id, err := random.NewMonotonicULID()
+					if !assert.NoError(t, err) {
+						return
+					}
+					s := id.String()
+					assert.Less(t, prev, s)
+					prev = s
+					mu.Lock()
+					all = append(all, s)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		seen := make(map[string]bool, len(all))
+		for _, s := range all {
+			require.False(t, seen[s], "duplicate ULID %s", s)
+			seen[s] = true
+		}
+	})
+}
+
+func TestParseULID(t *testing.T) {
+	t.Parallel()
+
+	const s = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	want, err := hex.DecodeString("01563e3ab5d3d6764c61efb99302bd5b")
+	require.NoError(t, err)
+
+	id, err := random.ParseULID(s)
+	require.NoError(t, err)
+	assert.Equal(t, want, id.Bytes())
+	assert.Equal(t, s, id.String())
+	assert.Equal(t, uint64(1469922850259), id.Timestamp())
+	assert.Equal(t, int64(1469922850259), id.Time().UnixMilli())
+
+	lower, err := random.ParseULID(strings.ToLower(s))
+	require.NoError(t, err)
+	assert.Equal(t, id, lower)
+
+	maxID, err := random.ParseULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	require.NoError(t, err)
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", maxID.String())
+
+	for _, invalid := range []string{
+		"",
+		s[:25],
+		s + "0",
+		"8ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA-",
+	} {
+		_, err := random.ParseULID(invalid)
+		require.ErrorIs(t, err, random.ErrInvalidULID, invalid)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		for range 100 {
+			id := random.NewULID()
+			parsed, err := random.ParseULID(id.String())
+			require.NoError(t, err)
+			assert.Equal(t, id, parsed)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(map[string]random.ULID{"id": id})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":"`+s+`"}`, string(data))
+
+		var got map[string]random.ULID
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, id, got["id"])
+	})
+}
+
+// constSource is a Source that always returns the same value.
+type constSource uint64
+
+func (s constSource) Uint64() uint64 { return uint64(s) }
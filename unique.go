@@ -0,0 +1,172 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// ErrKeyspaceTooSmall is returned when fewer distinct strings exist than were requested.
+var ErrKeyspaceTooSmall = errors.New("random: keyspace too small")
+
+// CollisionProbability returns the birthday-bound probability that n independent random
+// strings of the given length, picked from the provided character sets (Alphanumeric by
+// default), contain at least one duplicate.
+//
+// Example:
+//
+//	p := random.CollisionProbability(100000, 8, random.UnambiguousUpper) // ~1.3%
+func CollisionProbability(n, length int, charsets ...string) float64 {
+	return collisionProbability(n, length, len(joinCharsets(charsets)))
+}
+
+// collisionProbability is CollisionProbability for a charset of base distinct characters.
+func collisionProbability(n, length, base int) float64 {
+	if n < 2 {
+		return 0
+	}
+	keyspace := math.Pow(float64(base), float64(max(length, 0)))
+	pairs := float64(n) * float64(n-1) / 2
+	return -math.Expm1(-pairs / keyspace)
+}
+
+// denseCollisionProbability is the birthday-bound probability from which UniqueStats
+// reports a batch as Dense.
+const denseCollisionProbability = 0.5
+
+// UniqueStats reports how densely a batch generated by UniqueStrings fills its keyspace.
+type UniqueStats struct {
+	// CollisionProbability is the birthday-bound probability that the same number of
+	// independently generated strings would contain a duplicate, see CollisionProbability.
+	CollisionProbability float64
+	// Dense is a warning that CollisionProbability is 50% or more: the batch covers so much
+	// of its keyspace that valid codes are easy to guess. The batch itself is still complete
+	// and distinct; use a longer length or a larger charset if the codes must stay secret.
+	Dense bool
+}
+
+// UniqueStrings generates n distinct random strings of the given length using the provided
+// character sets (Alphanumeric by default). Uniqueness is guaranteed without retries, so it
+// works even when n equals the size of the keyspace.
+// Returns ErrKeyspaceTooSmall if fewer than n distinct strings exist. The stats are
+// returned for every complete batch; UniqueStats.Dense warns that the batch fills so much
+// of its keyspace that codes are easy to guess.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	codes, stats, err := random.UniqueStrings(100000, 10, random.UnambiguousUpper)
+//	if err != nil {
+//	    return err
+//	}
+//	if stats.Dense {
+//	    log.Printf("codes are dense (p=%.2f), consider a longer length", stats.CollisionProbability)
+//	}
+func UniqueStrings(n, length int, charsets ...string) ([]string, UniqueStats, error) {
+	return defaultGenerator.UniqueStrings(n, length, charsets...)
+}
+
+// UniqueStringsOf is like UniqueStrings but accepts Charset values and picks characters the
+// way StringOf does, as grapheme clusters, so non-ASCII charsets are supported.
+// This function is NOT cryptographically secure.
+//
+// Example:
+//
+//	cs := random.Charset(random.Uppercase).Minus("IO")
+//	codes, stats, err := random.UniqueStringsOf(1000, 6, cs)
+func UniqueStringsOf[C ~string](n, length int, charsets ...C) ([]string, UniqueStats, error) {
+	return defaultGenerator.uniqueStrings(n, length, charsetGraphemes(charsetStrings(charsets)))
+}
+
+// UniqueStrings generates n distinct random strings, drawing randomness from the
+// Generator's Source. See the package-level UniqueStrings for details.
+func (g *Generator) UniqueStrings(n, length int, charsets ...string) ([]string, UniqueStats, error) {
+	charset := joinCharsets(charsets)
+	chars := make([]string, len(charset))
+	for i := range chars {
+		chars[i] = charset[i : i+1]
+	}
+	return g.uniqueStrings(n, length, chars)
+}
+
+// uniqueStrings generates n distinct strings of length characters picked from chars.
+func (g *Generator) uniqueStrings(n, length int, chars []string) ([]string, UniqueStats, error) {
+	if n <= 0 {
+		return nil, UniqueStats{}, nil
+	}
+	length = max(length, 0)
+
+	var result []string
+	if keyspace, ok := keyspaceSize(len(chars), length); ok {
+		if uint64(n) > keyspace {
+			return nil, UniqueStats{}, fmt.Errorf("%w: %d strings requested, %d possible", ErrKeyspaceTooSmall, n, keyspace)
+		}
+		result = g.sampleKeyspace(n, length, chars, keyspace)
+	} else {
+		// The keyspace exceeds 2^64, so collisions are rare and can simply be redrawn
+		seen := make(map[string]bool, n)
+		result = make([]string, 0, n)
+		for len(result) < n {
+			s := g.pickChars(length, chars)
+			if !seen[s] {
+				seen[s] = true
+				result = append(result, s)
+			}
+		}
+	}
+
+	p := collisionProbability(n, length, len(chars))
+	return result, UniqueStats{CollisionProbability: p, Dense: p >= denseCollisionProbability}, nil
+}
+
+// sampleKeyspace picks n distinct indexes from the keyspace with Robert Floyd's sampling
+// algorithm, shuffles them and renders each index as a string over chars.
+func (g *Generator) sampleKeyspace(n, length int, chars []string, keyspace uint64) []string {
+	selected := make(map[uint64]bool, n)
+	indexes := make([]uint64, 0, n)
+	for j := keyspace - uint64(n); j < keyspace; j++ {
+		t := g.Uint64N(j + 1)
+		if selected[t] {
+			t = j
+		}
+		selected[t] = true
+		indexes = append(indexes, t)
+	}
+	// Floyd's algorithm yields a uniform set but not a uniform order
+	g.Shuffle(len(indexes), func(i, j int) { indexes[i], indexes[j] = indexes[j], indexes[i] })
+
+	base := uint64(len(chars))
+	result := make([]string, n)
+	digits := make([]string, length)
+	for i, index := range indexes {
+		for pos := length - 1; pos >= 0; pos-- {
+			digits[pos] = chars[index%base]
+			index /= base
+		}
+		result[i] = strings.Join(digits, "")
+	}
+	return result
+}
+
+// keyspaceSize returns base^length and reports whether it fits in a uint64.
+func keyspaceSize(base, length int) (uint64, bool) {
+	switch {
+	case length <= 0:
+		return 1, true
+	case base <= 1:
+		// A single character repeated any number of times is one string, so the loop
+		// below, which runs length times, is not needed
+		return uint64(base), true
+	}
+	size := uint64(1)
+	for range length {
+		hi, lo := bits.Mul64(size, uint64(base))
+		if hi != 0 {
+			return 0, false
+		}
+		size = lo
+	}
+	return size, true
+}
@@ -0,0 +1,116 @@
+package random_test
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestUniqueStrings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("large sparse batch", func(t *testing.T) {
+		t.Parallel()
+
+		codes, stats, err := random.UniqueStrings(100000, 10, random.UnambiguousUpper)
+		require.NoError(t, err)
+		require.Len(t, codes, 100000)
+		assert.False(t, stats.Dense)
+		assert.Less(t, stats.CollisionProbability, 0.01)
+
+		seen := make(map[string]bool, len(codes))
+		codeRegex := regexp.MustCompile(`^[` + random.UnambiguousUpper + `]{10}$`)
+		for _, code := range codes {
+			require.False(t, seen[code], "duplicate code %q", code)
+			seen[code] = true
+			require.Regexp(t, codeRegex, code)
+		}
+	})
+
+	t.Run("entire keyspace", func(t *testing.T) {
+		t.Parallel()
+
+		// A dense batch is complete and valid, so it is flagged in the stats, not as an error
+		codes, stats, err := random.UniqueStrings(1000, 3, random.Numeric)
+		require.NoError(t, err)
+		require.Len(t, codes, 1000)
+		assert.True(t, stats.Dense)
+		assert.Greater(t, stats.CollisionProbability, 0.99)
+
+		seen := make(map[string]bool, len(codes))
+		for _, code := range codes {
+			seen[code] = true
+		}
+		require.Len(t, seen, 1000)
+	})
+
+	t.Run("keyspace beyond uint64", func(t *testing.T) {
+		t.Parallel()
+
+		codes, stats, err := random.UniqueStrings(1000, 40)
+		require.NoError(t, err)
+		assert.False(t, stats.Dense)
+
+		seen := make(map[string]bool, len(codes))
+		for _, code := range codes {
+			require.Len(t, code, 40)
+			seen[code] = true
+		}
+		require.Len(t, seen, 1000)
+	})
+
+	t.Run("keyspace too small", func(t *testing.T) {
+		t.Parallel()
+
+		codes, _, err := random.UniqueStrings(101, 2, random.Numeric)
+		require.ErrorIs(t, err, random.ErrKeyspaceTooSmall)
+		require.Nil(t, codes)
+	})
+
+	t.Run("single-character charset", func(t *testing.T) {
+		t.Parallel()
+
+		// Only one string of any length exists, which must be known without iterating over the length
+		codes, _, err := random.UniqueStrings(2, 1<<40, "aaa")
+		require.ErrorIs(t, err, random.ErrKeyspaceTooSmall)
+		require.Nil(t, codes)
+
+		codes, stats, err := random.UniqueStrings(1, 5, "a")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"aaaaa"}, codes)
+		assert.False(t, stats.Dense)
+	})
+
+	t.Run("non-positive count", func(t *testing.T) {
+		t.Parallel()
+
+		codes, _, err := random.UniqueStrings(0, 8)
+		require.NoError(t, err)
+		require.Empty(t, codes)
+	})
+
+	t.Run("seeded generator is reproducible", func(t *testing.T) {
+		t.Parallel()
+
+		a, _, err := random.NewSeeded(8).UniqueStrings(50, 6)
+		require.NoError(t, err)
+		b, _, err := random.NewSeeded(8).UniqueStrings(50, 6)
+		require.NoError(t, err)
+		require.Equal(t, a, b)
+	})
+}
+
+func TestCollisionProbability(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 1-math.Exp(-0.1), random.CollisionProbability(2, 1, random.Numeric), 1e-12)
+	assert.InDelta(t, 0.0, random.CollisionProbability(1, 8), 1e-12)
+	assert.InDelta(t, 0.0, random.CollisionProbability(1000, 32), 1e-12)
+	assert.Greater(t, random.CollisionProbability(100, 2, random.Numeric), 0.99)
+	assert.InDelta(t, 2.29e-5, random.CollisionProbability(100000, 8), 1e-7)
+}
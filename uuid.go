@@ -0,0 +1,197 @@
+package random
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidUUID is returned when a UUID cannot be parsed.
+var ErrInvalidUUID = errors.New("random: invalid UUID")
+
+// UUID is an RFC 9562 universally unique identifier.
+type UUID [16]byte
+
+// NilUUID is the all-zero UUID.
+var NilUUID UUID
+
+// uuidV7Clock hands out strictly increasing (millisecond, counter) pairs for NewUUIDv7.
+var uuidV7Clock uuidClock
+
+// NewUUIDv4 returns a random (version 4) UUID generated with crypto/rand.
+//
+// Example:
+//
+//	id := random.NewUUIDv4() // "0b8d1e0c-2a5f-4c6e-9d7a-3f1b2c4d5e6f"
+func NewUUIDv4() UUID {
+	return secureGenerator.NewUUIDv4()
+}
+
+// NewUUIDv4 returns a random (version 4) UUID, drawing randomness from the Generator's Source.
+func (g *Generator) NewUUIDv4() UUID {
+	var u UUID
+	g.Read(u[:])
+	u.setVersion(4)
+	return u
+}
+
+// NewUUIDv7 returns a time-ordered (version 7) UUID: a 48-bit Unix millisecond timestamp,
+// a 12-bit counter and 62 bits of crypto/rand output.
+// UUIDs generated by one process are strictly increasing, even within the same millisecond:
+// the counter starts at a random value in the lower half of its range each millisecond and is
+// incremented for every further UUID. When it overflows, or when the clock moves backwards,
+// the timestamp is advanced past the last one issued. Safe for concurrent use.
+//
+// Example:
+//
+//	id := random.NewUUIDv7() // "01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e"
+//	id.Time()                // creation time, millisecond precision
+func NewUUIDv7() UUID {
+	ms, counter := uuidV7Clock.next(time.Now().UnixMilli())
+
+	var u UUID
+	secureGenerator.Read(u[8:])
+	binary.BigEndian.PutUint64(u[:8], uint64(ms)<<16|uint64(counter))
+	u.setVersion(7)
+	return u
+}
+
+// ParseUUID parses a UUID in the canonical form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+// as 32 hex digits without hyphens, or with a "urn:uuid:" prefix. Hex digits are
+// case-insensitive. Returns ErrInvalidUUID for any other input.
+//
+// Example:
+//
+//	id, err := random.ParseUUID("01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e")
+//	if err != nil {
+//	    return err
+//	}
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	s = strings.TrimPrefix(s, "urn:uuid:")
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return NilUUID, fmt.Errorf("%w: misplaced hyphens in %q", ErrInvalidUUID, s)
+		}
+		s = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	case 32:
+	default:
+		return NilUUID, fmt.Errorf("%w: unexpected length %d", ErrInvalidUUID, len(s))
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return NilUUID, fmt.Errorf("%w: %v", ErrInvalidUUID, err)
+	}
+	return u, nil
+}
+
+// UUIDFromBytes returns the UUID stored in b, which must be exactly 16 bytes long.
+func UUIDFromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != len(u) {
+		return NilUUID, fmt.Errorf("%w: got %d bytes, want 16", ErrInvalidUUID, len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// String returns the canonical lowercase form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx".
+func (u UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// Bytes returns a copy of the 16 bytes of the UUID.
+func (u UUID) Bytes() []byte {
+	return u[:]
+}
+
+// Version returns the version field of the UUID, e.g. 4 or 7.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Time returns the creation time embedded in a version 7 UUID, with millisecond precision.
+// Returns the zero time for other versions.
+func (u UUID) Time() time.Time {
+	if u.Version() != 7 {
+		return time.Time{}
+	}
+	ms := binary.BigEndian.Uint64(u[:8]) >> 16
+	return time.UnixMilli(int64(ms))
+}
+
+// IsNil reports whether u is the all-zero UUID.
+func (u UUID) IsNil() bool {
+	return u == NilUUID
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical form.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and accepts the forms of ParseUUID.
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// setVersion sets the version nibble and the RFC 9562 variant bits.
+func (u *UUID) setVersion(version byte) {
+	u[6] = u[6]&0x0f | version<<4
+	u[8] = u[8]&0x3f | 0x80
+}
+
+// uuidCounterBits is the width of the UUIDv7 rand_a field used as a counter.
+const uuidCounterBits = 12
+
+// uuidClock tracks the last timestamp and counter issued, so that consecutive UUIDv7
+// values are strictly increasing.
+type uuidClock struct {
+	mu      sync.Mutex
+	ms      int64
+	counter uint64
+}
+
+// next returns the timestamp and counter for the next UUID given the current Unix
+// millisecond time.
+func (c *uuidClock) next(now int64) (int64, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now > c.ms {
+		c.ms = now
+		c.counter = c.seed()
+		return c.ms, c.counter
+	}
+	c.counter++
+	if c.counter >= 1<<uuidCounterBits {
+		c.ms++
+		c.counter = c.seed()
+	}
+	return c.ms, c.counter
+}
+
+// seed returns a random initial counter with the top bit cleared, leaving at least
+// 2048 increments before the counter overflows.
+func (c *uuidClock) seed() uint64 {
+	return secureGenerator.Uint64N(1 << (uuidCounterBits - 1))
+}
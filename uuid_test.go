@@ -0,0 +1,163 @@
+package random_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNewUUIDv4(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[random.UUID]bool)
+	for range 1000 {
+		id := random.NewUUIDv4()
+		require.Regexp(t, uuidRegex, id.String())
+		require.Equal(t, 4, id.Version())
+		require.False(t, seen[id], "duplicate UUID %s", id)
+		seen[id] = true
+	}
+
+	t.Run("seeded generator", func(t *testing.T) {
+		t.Parallel()
+
+		a := random.NewSeeded(7).NewUUIDv4()
+		b := random.NewSeeded(7).NewUUIDv4()
+		assert.Equal(t, a, b)
+		assert.Equal(t, 4, a.Version())
+		assert.True(t, a.Time().IsZero())
+	})
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	t.Parallel()
+
+	t.Run("format and time", func(t *testing.T) {
+		t.Parallel()
+
+		before := time.Now().Truncate(time.Millisecond)
+		id := random.NewUUIDv7()
+		after := time.Now()
+
+		require.Regexp(t, uuidRegex, id.String())
+		assert.Equal(t, 7, id.Version())
+		assert.False(t, id.Time().Before(before))
+		// The timestamp may run ahead of the clock after counter overflows in other tests
+		assert.WithinDuration(t, after, id.Time(), time.Second)
+	})
+
+	t.Run("strictly increasing", func(t *testing.T) {
+		t.Parallel()
+
+		prev := random.NewUUIDv7()
+		for range 20000 {
+			id := random.NewUUIDv7()
+			require.Equal(t, 1, bytes.Compare(id.Bytes(), prev.Bytes()), "%s <= %s", id, prev)
+			require.Less(t, prev.String(), id.String())
+			prev = id
+		}
+	})
+
+	t.Run("unique across goroutines", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu   sync.Mutex
+			wg   sync.WaitGroup
+			seen = make(map[random.UUID]bool)
+		)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 1000 {
+					id := random.NewUUIDv7()
+					mu.Lock()
+					seen[id] = true
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, seen, 8000)
+	})
+}
+
+func TestParseUUID(t *testing.T) {
+	t.Parallel()
+
+	want := random.UUID{0x01, 0x92, 0x0b, 0x6c, 0x3c, 0x4f, 0x7a, 0x2b, 0x8e, 0x1d, 0x5f, 0x6a, 0x7b, 0x8c, 0x9d, 0x0e}
+
+	valid := []string{
+		"01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e",
+		"01920B6C-3C4F-7A2B-8E1D-5F6A7B8C9D0E",
+		"01920b6c3c4f7a2b8e1d5f6a7b8c9d0e",
+		"urn:uuid:01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e",
+	}
+	for _, s := range valid {
+		id, err := random.ParseUUID(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, id, s)
+	}
+	assert.Equal(t, "01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e", want.String())
+	assert.Equal(t, 7, want.Version())
+	assert.Equal(t, int64(0x01920b6c3c4f), want.Time().UnixMilli())
+
+	invalid := []string{
+		"",
+		"01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0",
+		"01920b6c3c4f-7a2b-8e1d-5f6a7b8c9d0e0",
+		"01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0g",
+		"{01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e}",
+	}
+	for _, s := range invalid {
+		_, err := random.ParseUUID(s)
+		require.ErrorIs(t, err, random.ErrInvalidUUID, s)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		for _, id := range []random.UUID{random.NewUUIDv4(), random.NewUUIDv7(), random.NilUUID} {
+			parsed, err := random.ParseUUID(id.String())
+			require.NoError(t, err)
+			assert.Equal(t, id, parsed)
+
+			fromBytes, err := random.UUIDFromBytes(id.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, id, fromBytes)
+		}
+
+		_, err := random.UUIDFromBytes(make([]byte, 15))
+		require.ErrorIs(t, err, random.ErrInvalidUUID)
+		assert.True(t, random.NilUUID.IsNil())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		type record struct {
+			ID random.UUID `json:"id"`
+		}
+		data, err := json.Marshal(record{ID: want})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":"01920b6c-3c4f-7a2b-8e1d-5f6a7b8c9d0e"}`, string(data))
+
+		var got record
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, want, got.ID)
+
+		require.ErrorIs(t, json.Unmarshal([]byte(`{"id":"nope"}`), &got), random.ErrInvalidUUID)
+	})
+}
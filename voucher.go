@@ -0,0 +1,158 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrTooManyCollisions is returned when a generator cannot find an unused code within
+// its retry budget, which usually means the keyspace is nearly exhausted.
+var ErrTooManyCollisions = errors.New("random: too many collisions")
+
+const (
+	defaultVoucherLength     = 10
+	defaultVoucherGroupSize  = 4
+	defaultVoucherSeparator  = "-"
+	defaultVoucherMaxRetries = 10
+)
+
+// VoucherConfig describes the format of voucher codes. The zero value produces codes
+// like "ABCD-EFGH-JK": 10 UnambiguousUpper characters in groups of 4 separated by "-".
+type VoucherConfig struct {
+	// Length is the number of random characters, excluding prefix and separators. Defaults to 10.
+	Length int
+	// Charset is the ASCII character set to pick from. Defaults to UnambiguousUpper.
+	Charset string
+	// GroupSize is the number of characters between separators. Defaults to 4;
+	// a negative value disables grouping.
+	GroupSize int
+	// Separator is placed between groups. Defaults to "-".
+	Separator string
+	// Prefix is prepended to every code as is, for example "SUMMER24-".
+	Prefix string
+	// MaxRetries is the number of consecutive collisions tolerated for a single code
+	// before giving up with ErrTooManyCollisions. Defaults to 10.
+	MaxRetries int
+}
+
+// VoucherStats reports what happened while generating a batch of vouchers.
+type VoucherStats struct {
+	// Requested is the number of codes asked for.
+	Requested int
+	// Generated is the number of new codes recorded in the store.
+	Generated int
+	// Attempts is the number of candidate codes checked against the store.
+	Attempts int
+	// Collisions is the number of candidates that were already in the store.
+	Collisions int
+	// Duration is the wall-clock time spent on the batch.
+	Duration time.Duration
+}
+
+// GenerateVouchers generates n new voucher codes formatted according to cfg.
+// Each candidate is recorded in store; candidates that are already present are retried,
+// so codes never collide with earlier batches sharing the store. A nil store only
+// guarantees uniqueness within the batch.
+// On error the codes generated so far are returned together with the stats.
+// Codes are generated with crypto/rand, so they cannot be predicted from issued ones.
+//
+// Example:
+//
+//	store := random.NewMemoryStore(previousCodes...)
+//	codes, stats, err := random.GenerateVouchers(ctx, 1000, random.VoucherConfig{Prefix: "XMAS-"}, store)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("generated %d codes with %d collisions", stats.Generated, stats.Collisions)
+func GenerateVouchers(ctx context.Context, n int, cfg VoucherConfig, store Store) ([]string, VoucherStats, error) {
+	return secureGenerator.GenerateVouchers(ctx, n, cfg, store)
+}
+
+// GenerateVouchers generates n new voucher codes, drawing randomness from the Generator's
+// Source. See the package-level GenerateVouchers for details.
+func (g *Generator) GenerateVouchers(ctx context.Context, n int, cfg VoucherConfig, store Store) ([]string, VoucherStats, error) {
+	start := time.Now()
+	stats := VoucherStats{Requested: n}
+	cfg = cfg.withDefaults()
+	if store == nil {
+		store = NewMemoryStore()
+	}
+
+	codes := make([]string, 0, max(n, 0))
+	for len(codes) < n {
+		code, err := g.nextVoucher(ctx, cfg, store, &stats)
+		if err != nil {
+			stats.Duration = time.Since(start)
+			return codes, stats, err
+		}
+		codes = append(codes, code)
+		stats.Generated++
+	}
+
+	stats.Duration = time.Since(start)
+	return codes, stats, nil
+}
+
+// nextVoucher returns a code that was not in store before, recording it there.
+func (g *Generator) nextVoucher(ctx context.Context, cfg VoucherConfig, store Store, stats *VoucherStats) (string, error) {
+	for range cfg.MaxRetries + 1 {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		code := FormatVoucher(g.StringN(cfg.Length, cfg.Charset), cfg)
+		stats.Attempts++
+		added, err := store.Add(ctx, code)
+		if err != nil {
+			return "", fmt.Errorf("random: record voucher: %w", err)
+		}
+		if added {
+			return code, nil
+		}
+		stats.Collisions++
+	}
+	return "", fmt.Errorf("%w: %d consecutive collisions", ErrTooManyCollisions, cfg.MaxRetries+1)
+}
+
+// FormatVoucher splits raw into groups and adds the separator and prefix from cfg,
+// applying the same defaults as GenerateVouchers.
+//
+// Example:
+//
+//	random.FormatVoucher("ABCDEFGHJK", random.VoucherConfig{}) // "ABCD-EFGH-JK"
+func FormatVoucher(raw string, cfg VoucherConfig) string {
+	cfg = cfg.withDefaults()
+
+	var sb strings.Builder
+	sb.WriteString(cfg.Prefix)
+	for i, r := range []rune(raw) {
+		if cfg.GroupSize > 0 && i > 0 && i%cfg.GroupSize == 0 {
+			sb.WriteString(cfg.Separator)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// withDefaults returns cfg with unset fields replaced by their defaults.
+func (cfg VoucherConfig) withDefaults() VoucherConfig {
+	if cfg.Length <= 0 {
+		cfg.Length = defaultVoucherLength
+	}
+	if cfg.Charset == "" {
+		cfg.Charset = UnambiguousUpper
+	}
+	if cfg.GroupSize == 0 {
+		cfg.GroupSize = defaultVoucherGroupSize
+	}
+	if cfg.Separator == "" {
+		cfg.Separator = defaultVoucherSeparator
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultVoucherMaxRetries
+	}
+	return cfg
+}
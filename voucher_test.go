@@ -0,0 +1,131 @@
+package random_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/random/v2"
+)
+
+func TestGenerateVouchers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default format", func(t *testing.T) {
+		t.Parallel()
+
+		codes, stats, err := random.GenerateVouchers(context.Background(), 100, random.VoucherConfig{}, nil)
+		require.NoError(t, err)
+		require.Len(t, codes, 100)
+
+		codeRegex := regexp.MustCompile(`^[` + random.UnambiguousUpper + `]{4}-[` + random.UnambiguousUpper + `]{4}-[` + random.UnambiguousUpper + `]{2}$`)
+		for _, code := range codes {
+			require.Regexp(t, codeRegex, code)
+		}
+
+		assert.Equal(t, 100, stats.Requested)
+		assert.Equal(t, 100, stats.Generated)
+		assert.Equal(t, stats.Generated+stats.Collisions, stats.Attempts)
+		assert.Greater(t, stats.Duration, time.Duration(0))
+	})
+
+	t.Run("custom format", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := random.VoucherConfig{
+			Length:    9,
+			Charset:   random.Numeric,
+			GroupSize: 3,
+			Separator: " ",
+			Prefix:    "XMAS ",
+		}
+		codes, _, err := random.GenerateVouchers(context.Background(), 10, cfg, nil)
+		require.NoError(t, err)
+		for _, code := range codes {
+			require.Regexp(t, `^XMAS [0-9]{3} [0-9]{3} [0-9]{3}$`, code)
+		}
+	})
+
+	t.Run("no grouping", func(t *testing.T) {
+		t.Parallel()
+
+		codes, _, err := random.GenerateVouchers(context.Background(), 5, random.VoucherConfig{GroupSize: -1}, nil)
+		require.NoError(t, err)
+		for _, code := range codes {
+			require.Regexp(t, `^[`+random.UnambiguousUpper+`]{10}$`, code)
+		}
+	})
+
+	t.Run("avoids codes from previous batches", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := random.VoucherConfig{Length: 2, Charset: "ABCDE", MaxRetries: 1000}
+		store := random.NewMemoryStore()
+
+		first, _, err := random.GenerateVouchers(ctx, 15, cfg, store)
+		require.NoError(t, err)
+
+		second, stats, err := random.GenerateVouchers(ctx, 10, cfg, store)
+		require.NoError(t, err)
+		assert.Positive(t, stats.Collisions)
+
+		seen := make(map[string]bool)
+		for _, code := range append(first, second...) {
+			require.False(t, seen[code], "duplicate code %q", code)
+			seen[code] = true
+		}
+		assert.Equal(t, 25, store.Len())
+	})
+
+	t.Run("exhausted keyspace", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := random.VoucherConfig{Length: 1, Charset: "AB"}
+		codes, stats, err := random.GenerateVouchers(context.Background(), 3, cfg, nil)
+		require.ErrorIs(t, err, random.ErrTooManyCollisions)
+		assert.Len(t, codes, 2)
+		assert.Equal(t, 2, stats.Generated)
+		assert.GreaterOrEqual(t, stats.Collisions, 11)
+		assert.Equal(t, stats.Generated+stats.Collisions, stats.Attempts)
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		t.Parallel()
+
+		codes, _, err := random.GenerateVouchers(context.Background(), 3, random.VoucherConfig{}, failingStore{})
+		require.ErrorIs(t, err, errStoreDown)
+		assert.Empty(t, codes)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err := random.GenerateVouchers(ctx, 3, random.VoucherConfig{}, nil)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestFormatVoucher(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ABCD-EFGH-JK", random.FormatVoucher("ABCDEFGHJK", random.VoucherConfig{}))
+	assert.Equal(t, "P-AB.CD", random.FormatVoucher("ABCD", random.VoucherConfig{Prefix: "P-", GroupSize: 2, Separator: "."}))
+	assert.Equal(t, "ABCD", random.FormatVoucher("ABCD", random.VoucherConfig{GroupSize: -1}))
+}
+
+var errStoreDown = errors.New("store down")
+
+type failingStore struct{}
+
+func (failingStore) Add(context.Context, string) (bool, error) {
+	return false, errStoreDown
+}
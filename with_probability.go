@@ -1,13 +1,20 @@
 package random
 
 import (
-	"math/rand"
+	"maps"
+	"slices"
 )
 
 // GetRandomWithProbabilities returns a random item from a slice with given probabilities.
 // Probabilities are relative weights and do not need to sum to any specific value.
 // Returns the zero value of T if inputs are invalid (empty, mismatched lengths, negative probabilities).
 func GetRandomWithProbabilities[T any](items []T, probabilities []float64) T {
+	return GetRandomWithProbabilitiesFrom(defaultGenerator, items, probabilities)
+}
+
+// GetRandomWithProbabilitiesFrom is like GetRandomWithProbabilities but draws randomness from g.
+// Go methods cannot have type parameters, so the Generator is passed as an argument.
+func GetRandomWithProbabilitiesFrom[T any](g *Generator, items []T, probabilities []float64) T {
 	var zero T
 	if len(items) == 0 || len(probabilities) == 0 || len(items) != len(probabilities) {
 		return zero
@@ -25,7 +32,7 @@ func GetRandomWithProbabilities[T any](items []T, probabilities []float64) T {
 		return zero
 	}
 
-	randValue := randomFloat64(sumProbabilities)
+	randValue := g.randomFloat64(sumProbabilities)
 	accumulated := 0.0
 
 	for i, item := range items {
@@ -43,6 +50,12 @@ func GetRandomWithProbabilities[T any](items []T, probabilities []float64) T {
 // Probabilities are relative weights and do not need to sum to any specific value.
 // Returns the zero value of T if inputs are invalid (empty, negative probabilities).
 func GetRandomStructWithProbabilities[T interface{ GetProbability() float64 }](items []T) T {
+	return GetRandomStructWithProbabilitiesFrom(defaultGenerator, items)
+}
+
+// GetRandomStructWithProbabilitiesFrom is like GetRandomStructWithProbabilities but draws
+// randomness from g.
+func GetRandomStructWithProbabilitiesFrom[T interface{ GetProbability() float64 }](g *Generator, items []T) T {
 	var zero T
 	if len(items) == 0 {
 		return zero
@@ -61,7 +74,7 @@ func GetRandomStructWithProbabilities[T interface{ GetProbability() float64 }](i
 		return zero
 	}
 
-	randValue := randomFloat64(sumProbabilities)
+	randValue := g.randomFloat64(sumProbabilities)
 	accumulated := 0.0
 
 	for _, item := range items {
@@ -77,6 +90,12 @@ func GetRandomStructWithProbabilities[T interface{ GetProbability() float64 }](i
 // GetRandomMapItemWithProbabilities returns random item
 // from a map where values are probabilities
 func GetRandomMapItemWithProbabilities(items map[string]float64) string {
+	return defaultGenerator.GetRandomMapItemWithProbabilities(items)
+}
+
+// GetRandomMapItemWithProbabilities returns random item from a map where values are
+// probabilities, drawing randomness from the Generator's Source.
+func (g *Generator) GetRandomMapItemWithProbabilities(items map[string]float64) string {
 	if len(items) == 0 {
 		return ""
 	}
@@ -93,19 +112,19 @@ func GetRandomMapItemWithProbabilities(items map[string]float64) string {
 		return ""
 	}
 
-	randValue := randomFloat64(sumProbabilities)
+	randValue := g.randomFloat64(sumProbabilities)
 	accumulated := 0.0
 
-	var lastKey string
-	for k, v := range items {
-		lastKey = k
-		accumulated += v
+	// Walk keys in sorted order so seeded generators pick the same key on every run
+	keys := slices.Sorted(maps.Keys(items))
+	for _, k := range keys {
+		accumulated += items[k]
 		if randValue < accumulated {
 			return k
 		}
 	}
 
-	return lastKey
+	return keys[len(keys)-1]
 }
 
 // GetRandomMapItemWithPercent returns a random key from a map using weighted selection.
@@ -114,6 +133,12 @@ func GetRandomMapItemWithProbabilities(items map[string]float64) string {
 // relative probabilities, making it suitable for lootbox mechanics.
 // Returns empty string if the map is empty or contains only negative values.
 func GetRandomMapItemWithPercent(items map[string]float64) string {
+	return defaultGenerator.GetRandomMapItemWithPercent(items)
+}
+
+// GetRandomMapItemWithPercent returns a random key from a map using weighted selection,
+// drawing randomness from the Generator's Source.
+func (g *Generator) GetRandomMapItemWithPercent(items map[string]float64) string {
 	if len(items) == 0 {
 		return ""
 	}
@@ -132,22 +157,17 @@ func GetRandomMapItemWithPercent(items map[string]float64) string {
 	}
 
 	// Weighted random selection
-	randValue := randomFloat64(sumProbabilities)
+	randValue := g.randomFloat64(sumProbabilities)
 	accumulated := 0.0
 
-	var lastKey string
-	for k, v := range items {
-		lastKey = k
-		accumulated += v
+	// Walk keys in sorted order so seeded generators pick the same key on every run
+	keys := slices.Sorted(maps.Keys(items))
+	for _, k := range keys {
+		accumulated += items[k]
 		if randValue < accumulated {
 			return k
 		}
 	}
 
-	return lastKey
-}
-
-// randomFloat64 returns a random float64 value in the range [0, max).
-func randomFloat64(max float64) float64 {
-	return rand.Float64() * max
+	return keys[len(keys)-1]
 }